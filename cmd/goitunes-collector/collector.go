@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+var (
+	errScheduleEnded   = errors.New("schedule has no further runs")
+	errShutdownTimeout = errors.New("shutdown timed out waiting for charts being fetched")
+)
+
+// target is a storefront and device charts are collected for.
+type target struct {
+	region string
+	device string
+	client *goitunes.Client
+}
+
+// job is a single chart collected on every run.
+type job struct {
+	target    *target
+	genre     goitunes.Genre
+	chartType goitunes.ChartType
+}
+
+func (j job) String() string {
+	return fmt.Sprintf("%s/%s/%s/%s", j.target.region, j.target.device, j.genre, j.chartType)
+}
+
+// collector fetches the configured charts on a schedule and hands them to a sink.
+type collector struct {
+	jobs         []job
+	sink         sink
+	health       *health
+	chartOptions []goitunes.Top200Option
+	concurrency  int
+}
+
+// newCollector creates a client per region and device and a job per chart of cfg.
+func newCollector(cfg *config, out sink, clientOptions ...goitunes.Option) (*collector, error) {
+	c := &collector{
+		sink:         out,
+		chartOptions: []goitunes.Top200Option{goitunes.WithRange(1, cfg.Limit)},
+		concurrency:  cfg.Concurrency,
+	}
+
+	for _, region := range cfg.Regions {
+		for _, device := range cfg.Devices {
+			opts := append([]goitunes.Option{goitunes.WithPlatform(goitunes.Platform(device))}, clientOptions...)
+
+			client, err := goitunes.New(region, opts...)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create client for %s/%s: %w", region, device, err)
+			}
+
+			t := &target{region: region, device: device, client: client}
+
+			for _, genre := range cfg.Genres {
+				for _, chartType := range cfg.ChartTypes {
+					c.jobs = append(c.jobs, job{
+						target:    t,
+						genre:     goitunes.Genre(genre),
+						chartType: goitunes.ChartType(chartType),
+					})
+				}
+			}
+		}
+	}
+
+	c.health = newHealth(time.Now(), len(c.jobs))
+
+	return c, nil
+}
+
+// run collects the charts at every time of sched until ctx ends.
+func (c *collector) run(ctx context.Context, sched schedule) error {
+	for {
+		next := sched.next(time.Now())
+		if next.IsZero() {
+			return errScheduleEnded
+		}
+
+		c.health.scheduled(next)
+
+		timer := time.NewTimer(time.Until(next))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return nil
+		case <-timer.C:
+		}
+
+		c.collect(ctx)
+	}
+}
+
+// collect runs every job once. Charts already being fetched when ctx ends are
+// finished and stored; jobs not yet started are skipped.
+func (c *collector) collect(ctx context.Context) {
+	started := time.Now()
+	jobCtx := context.WithoutCancel(ctx)
+
+	c.health.started()
+
+	results := make([]error, len(c.jobs))
+
+	group := new(errgroup.Group)
+	group.SetLimit(c.concurrency)
+
+	for i, j := range c.jobs {
+		group.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				results[i] = err
+
+				return nil
+			}
+
+			snapshot, err := c.sink.collect(jobCtx, j.target.client, j.genre, j.chartType, c.chartOptions...)
+			if err != nil {
+				results[i] = err
+
+				log.Printf("collect %s: %v", j, err)
+
+				return nil
+			}
+
+			log.Printf("collect %s: %d items", j, len(snapshot.Items))
+
+			return nil
+		})
+	}
+
+	//nolint:errcheck // jobs record their errors in results
+	group.Wait()
+
+	var failed, skipped int
+
+	for _, err := range results {
+		switch {
+		case err == nil:
+		case errors.Is(err, context.Canceled) && ctx.Err() != nil:
+			skipped++
+		default:
+			failed++
+		}
+	}
+
+	c.health.finished(time.Now(), failed, skipped)
+
+	log.Printf("run finished in %s: %d charts, %d failed, %d skipped",
+		time.Since(started).Round(time.Millisecond), len(c.jobs), failed, skipped)
+}
+
+func (c *collector) close() error {
+	return c.sink.close()
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+// chartClient answers chart requests with an empty chart and fails one genre.
+type chartClient struct {
+	failGenre string
+}
+
+func (c *chartClient) Do(req *http.Request) (*http.Response, error) {
+	if req.URL.Query().Get("genreId") == c.failGenre {
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(strings.NewReader("")),
+			Request:    req,
+		}, nil
+	}
+
+	body := `{"pageData":{"segmentedControl":{"segments":[{"pageData":{"selectedChart":{"adamIds":[]}}}]}}}`
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func newTestCollector(t *testing.T, cfg *config, failGenre string) *collector {
+	t.Helper()
+
+	cfg.setDefaults()
+
+	out, err := newSink(context.Background(), cfg.Sink)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	c, err := newCollector(cfg, out, goitunes.WithHTTPClient(&chartClient{failGenre: failGenre}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = c.close()
+	})
+
+	return c
+}
+
+func healthOf(t *testing.T, c *collector) (int, healthStatus) {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	c.health.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	var status healthStatus
+	if err := json.NewDecoder(rec.Body).Decode(&status); err != nil {
+		t.Fatalf("Failed to decode health status: %v", err)
+	}
+
+	return rec.Code, status
+}
+
+func TestCollector_Collect(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	c := newTestCollector(t, &config{
+		Regions:    []string{"us", "gb"},
+		Genres:     []string{"36", "6014"},
+		ChartTypes: []string{"topfree"},
+		Devices:    []string{"iphone", "ipad"},
+		Sink:       sinkConfig{Type: sinkTypeJSON, Path: dir},
+	}, "6014")
+
+	if len(c.jobs) != 8 {
+		t.Fatalf("Expected 8 jobs, got %d", len(c.jobs))
+	}
+
+	if code, status := healthOf(t, c); code != http.StatusOK || status.Status != healthStarting {
+		t.Errorf("Expected starting status, got %d %+v", code, status)
+	}
+
+	c.collect(context.Background())
+
+	for _, region := range []string{"us", "gb"} {
+		for _, device := range []string{"iphone", "ipad"} {
+			files, err := filepath.Glob(filepath.Join(dir, region, device, "36-topfree-*.json"))
+			if err != nil || len(files) != 1 {
+				t.Fatalf("Expected one snapshot file for %s/%s, got %v, %v", region, device, files, err)
+			}
+
+			data, err := os.ReadFile(files[0])
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var snapshot goitunes.ChartSnapshot
+			if err = json.Unmarshal(data, &snapshot); err != nil {
+				t.Fatalf("Failed to decode snapshot: %v", err)
+			}
+
+			if snapshot.Storefront != region || snapshot.Device != device || snapshot.GenreID != "36" {
+				t.Errorf("Unexpected snapshot %s/%s/%s", snapshot.Storefront, snapshot.Device, snapshot.GenreID)
+			}
+		}
+	}
+
+	code, status := healthOf(t, c)
+	if code != http.StatusOK || status.Status != healthDegraded || status.Failed != 4 || status.Charts != 8 {
+		t.Errorf("Expected degraded status with 4 failed charts, got %d %+v", code, status)
+	}
+
+	if status.LastRunAt == nil || status.LastSuccessAt != nil {
+		t.Errorf("Expected a last run without a successful run, got %+v", status)
+	}
+}
+
+func TestCollector_Collect_SQLite(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "charts.db")
+
+	c := newTestCollector(t, &config{
+		Regions:    []string{"us"},
+		Genres:     []string{"36", "6014"},
+		ChartTypes: []string{"topfree", "toppaid"},
+		Sink:       sinkConfig{Type: sinkTypeSQLite, Path: path},
+	}, "")
+
+	c.collect(context.Background())
+
+	if code, status := healthOf(t, c); code != http.StatusOK || status.Status != healthOK || status.Failed != 0 {
+		t.Errorf("Expected every chart to be recorded, got %d %+v", code, status)
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("Expected the chart store at %s: %v", path, err)
+	}
+}
+
+func TestCollector_Collect_AllFailed(t *testing.T) {
+	t.Parallel()
+
+	c := newTestCollector(t, &config{
+		Regions:    []string{"us"},
+		Genres:     []string{"6014"},
+		ChartTypes: []string{"topfree", "toppaid"},
+		Sink:       sinkConfig{Type: sinkTypeJSON, Path: t.TempDir()},
+	}, "6014")
+
+	c.collect(context.Background())
+
+	if code, status := healthOf(t, c); code != http.StatusServiceUnavailable || status.Status != healthFailing {
+		t.Errorf("Expected failing status, got %d %+v", code, status)
+	}
+}
+
+func TestCollector_Collect_Shutdown(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	c := newTestCollector(t, &config{
+		Regions:    []string{"us"},
+		Genres:     []string{"36"},
+		ChartTypes: []string{"topfree"},
+		Sink:       sinkConfig{Type: sinkTypeJSON, Path: dir},
+	}, "")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	c.collect(ctx)
+
+	if _, status := healthOf(t, c); status.Skipped != 1 || status.Failed != 0 {
+		t.Errorf("Expected the chart to be skipped, got %+v", status)
+	}
+
+	if files, _ := filepath.Glob(filepath.Join(dir, "*", "*", "*.json")); len(files) != 0 {
+		t.Errorf("Expected no snapshot files, got %v", files)
+	}
+}
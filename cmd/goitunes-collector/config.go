@@ -0,0 +1,193 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+// Sink types supported in the sink section of the config.
+const (
+	sinkTypeNDJSON = "ndjson"
+	sinkTypeJSON   = "json"
+	sinkTypeSQLite = "sqlite"
+)
+
+const defaultChartLimit = 200
+
+var (
+	errEmptySchedule   = errors.New("schedule is required")
+	errNoRegions       = errors.New("at least one region is required")
+	errNoGenres        = errors.New("at least one genre is required")
+	errNoChartTypes    = errors.New("at least one chart type is required")
+	errInvalidGenre    = errors.New("invalid genre")
+	errGenreNotCharted = errors.New("genre is not charted on device")
+	errInvalidChart    = errors.New("invalid chart type")
+	errInvalidLimit    = errors.New("limit must be between 1 and 200")
+	errUnknownSinkType = errors.New("unknown sink type")
+	errSinkPathMissing = errors.New("sink path is required")
+)
+
+// config is the collector configuration. It is read from YAML; JSON is valid YAML,
+// so JSON configs use the same keys.
+type config struct {
+	// Schedule is a cron expression with five fields (minute hour day-of-month
+	// month day-of-week), a descriptor such as "@hourly" or "@every 30m".
+	Schedule string `yaml:"schedule"`
+
+	// Regions, genres, chart types and devices span the charts collected on every run.
+	Regions    []string `yaml:"regions"`
+	Genres     []string `yaml:"genres"`
+	ChartTypes []string `yaml:"chartTypes"`
+	Devices    []string `yaml:"devices"` // Platform names, iPhone when empty
+
+	// Limit is the number of chart positions collected, 200 when zero.
+	Limit int `yaml:"limit"`
+
+	// Concurrency bounds the charts fetched at once, goitunes.DefaultGenreConcurrency when zero.
+	Concurrency int `yaml:"concurrency"`
+
+	Sink sinkConfig `yaml:"sink"`
+
+	// HealthAddr is the listen address of the health endpoint; empty disables it.
+	HealthAddr string `yaml:"healthAddr"`
+}
+
+// sinkConfig selects where snapshots are written.
+type sinkConfig struct {
+	// Type is one of "ndjson" (default), "json" or "sqlite".
+	Type string `yaml:"type"`
+	// Path is the output file for ndjson (stdout when empty), the directory for
+	// json and the database for sqlite.
+	Path string `yaml:"path"`
+}
+
+// loadConfig reads, defaults and validates the config file at path.
+func loadConfig(path string) (*config, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open config: %w", err)
+	}
+
+	defer func() {
+		_ = file.Close()
+	}()
+
+	var cfg config
+
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+
+	if err = decoder.Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+
+	cfg.setDefaults()
+
+	if err = cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+
+	return &cfg, nil
+}
+
+// validateGenre checks that genre is charted on every device. Deprecated Kids
+// genres are rejected: they are age bands and chart nothing as a genre.
+func validateGenre(genre goitunes.Genre, devices []string) error {
+	switch {
+	case goitunes.AgeBand(genre).IsValid():
+		return fmt.Errorf("%w: %q is a Kids age band, not a genre", errInvalidGenre, genre)
+	case !genre.IsValid():
+		return fmt.Errorf("%w: %q", errInvalidGenre, genre)
+	}
+
+	for _, device := range devices {
+		if !genre.SupportsPlatform(goitunes.Platform(device)) {
+			return fmt.Errorf("%w: %q (%s) on %s", errGenreNotCharted, genre, genre.Name(), device)
+		}
+	}
+
+	return nil
+}
+
+func (c *config) setDefaults() {
+	if len(c.Devices) == 0 {
+		c.Devices = []string{goitunes.PlatformIPhone.String()}
+	}
+
+	if c.Limit == 0 {
+		c.Limit = defaultChartLimit
+	}
+
+	if c.Concurrency <= 0 {
+		c.Concurrency = goitunes.DefaultGenreConcurrency
+	}
+
+	if c.Sink.Type == "" {
+		c.Sink.Type = sinkTypeNDJSON
+	}
+
+	for i, chartType := range c.ChartTypes {
+		c.ChartTypes[i] = strings.ToLower(chartType)
+	}
+}
+
+// validate checks the fields that are not validated when clients are created.
+// Regions and devices are checked by goitunes.New.
+func (c *config) validate() error {
+	if strings.TrimSpace(c.Schedule) == "" {
+		return errEmptySchedule
+	}
+
+	sched, err := parseSchedule(c.Schedule)
+	if err != nil {
+		return err
+	}
+
+	if sched.next(time.Now()).IsZero() {
+		return fmt.Errorf("%w: %q never runs", errInvalidSchedule, c.Schedule)
+	}
+
+	switch {
+	case len(c.Regions) == 0:
+		return errNoRegions
+	case len(c.Genres) == 0:
+		return errNoGenres
+	case len(c.ChartTypes) == 0:
+		return errNoChartTypes
+	case c.Limit < 1 || c.Limit > defaultChartLimit:
+		return errInvalidLimit
+	}
+
+	for _, genre := range c.Genres {
+		if err = validateGenre(goitunes.Genre(genre), c.Devices); err != nil {
+			return err
+		}
+	}
+
+	for _, chartType := range c.ChartTypes {
+		switch goitunes.ChartType(chartType) {
+		case goitunes.ChartTypeTopFree, goitunes.ChartTypeTopPaid, goitunes.ChartTypeTopGrossing:
+		default:
+			return fmt.Errorf("%w: %q", errInvalidChart, chartType)
+		}
+	}
+
+	switch c.Sink.Type {
+	case sinkTypeNDJSON:
+	case sinkTypeJSON, sinkTypeSQLite:
+		if c.Sink.Path == "" {
+			return fmt.Errorf("%w for %s sink", errSinkPathMissing, c.Sink.Type)
+		}
+	default:
+		return fmt.Errorf("%w: %q", errUnknownSinkType, c.Sink.Type)
+	}
+
+	return nil
+}
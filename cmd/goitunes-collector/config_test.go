@@ -0,0 +1,164 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+func writeConfig(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	return path
+}
+
+func TestLoadConfig_YAML(t *testing.T) {
+	t.Parallel()
+
+	path := writeConfig(t, "collector.yaml", `
+schedule: "0 * * * *"
+regions: [us, gb]
+genres: ["36", "6014"]
+chartTypes: [TopFree, toppaid]
+sink:
+  type: json
+  path: /var/lib/charts
+healthAddr: ":8080"
+`)
+
+	cfg, err := loadConfig(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(cfg.Regions) != 2 || len(cfg.Genres) != 2 || cfg.ChartTypes[0] != "topfree" {
+		t.Errorf("Unexpected charts %v %v %v", cfg.Regions, cfg.Genres, cfg.ChartTypes)
+	}
+
+	if len(cfg.Devices) != 1 || cfg.Devices[0] != "iphone" {
+		t.Errorf("Expected default device iphone, got %v", cfg.Devices)
+	}
+
+	if cfg.Limit != defaultChartLimit || cfg.Concurrency != goitunes.DefaultGenreConcurrency {
+		t.Errorf("Expected default limit and concurrency, got %d and %d", cfg.Limit, cfg.Concurrency)
+	}
+
+	if cfg.Sink.Type != sinkTypeJSON || cfg.Sink.Path != "/var/lib/charts" || cfg.HealthAddr != ":8080" {
+		t.Errorf("Unexpected sink %+v and health address %q", cfg.Sink, cfg.HealthAddr)
+	}
+}
+
+func TestLoadConfig_JSON(t *testing.T) {
+	t.Parallel()
+
+	path := writeConfig(t, "collector.json", `{
+  "schedule": "@every 6h",
+  "regions": ["jp"],
+  "genres": ["36"],
+  "chartTypes": ["topgrossing"],
+  "devices": ["ipad", "mac"],
+  "limit": 50
+}`)
+
+	cfg, err := loadConfig(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(cfg.Devices) != 2 || cfg.Limit != 50 || cfg.Sink.Type != sinkTypeNDJSON {
+		t.Errorf("Unexpected config %+v", cfg)
+	}
+}
+
+func TestLoadConfig_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{
+			name:    "missing schedule",
+			content: `{"regions": ["us"], "genres": ["36"], "chartTypes": ["topfree"]}`,
+			wantErr: errEmptySchedule,
+		},
+		{
+			name:    "bad schedule",
+			content: `{"schedule": "every hour", "regions": ["us"], "genres": ["36"], "chartTypes": ["topfree"]}`,
+			wantErr: errInvalidSchedule,
+		},
+		{
+			name:    "no regions",
+			content: `{"schedule": "@hourly", "genres": ["36"], "chartTypes": ["topfree"]}`,
+			wantErr: errNoRegions,
+		},
+		{
+			name:    "unknown genre",
+			content: `{"schedule": "@hourly", "regions": ["us"], "genres": ["1"], "chartTypes": ["topfree"]}`,
+			wantErr: errInvalidGenre,
+		},
+		{
+			name:    "deprecated Kids genre",
+			content: `{"schedule": "@hourly", "regions": ["us"], "genres": ["KIDS_6_8"], "chartTypes": ["topfree"]}`,
+			wantErr: errInvalidGenre,
+		},
+		{
+			name: "iPhone genre on Mac",
+			content: `{"schedule": "@hourly", "regions": ["us"], "genres": ["36", "6014"], "chartTypes": ["topfree"],
+				"devices": ["iphone", "mac"]}`,
+			wantErr: errGenreNotCharted,
+		},
+		{
+			name:    "Mac genre on iPhone",
+			content: `{"schedule": "@hourly", "regions": ["us"], "genres": ["12002"], "chartTypes": ["topfree"]}`,
+			wantErr: errGenreNotCharted,
+		},
+		{
+			name:    "unknown chart type",
+			content: `{"schedule": "@hourly", "regions": ["us"], "genres": ["36"], "chartTypes": ["topnew"]}`,
+			wantErr: errInvalidChart,
+		},
+		{
+			name: "limit too large",
+			content: `{"schedule": "@hourly", "regions": ["us"], "genres": ["36"], "chartTypes": ["topfree"],
+				"limit": 500}`,
+			wantErr: errInvalidLimit,
+		},
+		{
+			name: "sink without path",
+			content: `{"schedule": "@hourly", "regions": ["us"], "genres": ["36"], "chartTypes": ["topfree"],
+				"sink": {"type": "sqlite"}}`,
+			wantErr: errSinkPathMissing,
+		},
+		{
+			name: "unknown sink",
+			content: `{"schedule": "@hourly", "regions": ["us"], "genres": ["36"], "chartTypes": ["topfree"],
+				"sink": {"type": "kafka"}}`,
+			wantErr: errUnknownSinkType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := loadConfig(writeConfig(t, "collector.json", tt.content))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	if _, err := loadConfig(writeConfig(t, "collector.yaml", "schedule: '@hourly'\nregion: us\n")); err == nil {
+		t.Error("Expected error for unknown field")
+	}
+}
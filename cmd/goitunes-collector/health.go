@@ -0,0 +1,128 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Health states reported by the health endpoint.
+const (
+	healthStarting = "starting" // no run has finished yet
+	healthOK       = "ok"       // every chart of the last run was collected
+	healthDegraded = "degraded" // some charts of the last run failed
+	healthFailing  = "failing"  // every chart of the last run failed
+)
+
+// health tracks the collector runs for the health endpoint.
+type health struct {
+	startedAt time.Time
+	jobs      int
+
+	mu            sync.Mutex
+	running       bool
+	lastRunAt     time.Time
+	lastSuccessAt time.Time
+	nextRunAt     time.Time
+	lastFailed    int
+	lastSkipped   int
+}
+
+// healthStatus is the JSON body of the health endpoint.
+type healthStatus struct {
+	Status        string     `json:"status"`
+	StartedAt     time.Time  `json:"startedAt"`
+	LastRunAt     *time.Time `json:"lastRunAt,omitempty"`
+	LastSuccessAt *time.Time `json:"lastSuccessAt,omitempty"` // Last run without failed charts
+	NextRunAt     *time.Time `json:"nextRunAt,omitempty"`
+	Running       bool       `json:"running"`
+	Charts        int        `json:"charts"`
+	Failed        int        `json:"failed"`
+	Skipped       int        `json:"skipped"`
+}
+
+func newHealth(startedAt time.Time, jobs int) *health {
+	return &health{startedAt: startedAt, jobs: jobs}
+}
+
+func (h *health) scheduled(next time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.nextRunAt = next
+}
+
+func (h *health) started() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.running = true
+}
+
+func (h *health) finished(at time.Time, failed, skipped int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.running = false
+	h.lastRunAt = at
+	h.lastFailed = failed
+	h.lastSkipped = skipped
+
+	if failed == 0 {
+		h.lastSuccessAt = at
+	}
+}
+
+func (h *health) status() healthStatus {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	status := healthStatus{
+		Status:    healthOK,
+		StartedAt: h.startedAt,
+		Running:   h.running,
+		Charts:    h.jobs,
+		Failed:    h.lastFailed,
+		Skipped:   h.lastSkipped,
+		LastRunAt: optionalTime(h.lastRunAt),
+		NextRunAt: optionalTime(h.nextRunAt),
+
+		LastSuccessAt: optionalTime(h.lastSuccessAt),
+	}
+
+	switch {
+	case h.lastRunAt.IsZero():
+		status.Status = healthStarting
+	case h.lastFailed > 0 && h.lastFailed+h.lastSkipped >= h.jobs:
+		status.Status = healthFailing
+	case h.lastFailed > 0:
+		status.Status = healthDegraded
+	}
+
+	return status
+}
+
+// ServeHTTP reports the health status as JSON. It answers 503 Service Unavailable
+// when every chart of the last run failed.
+func (h *health) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	status := h.status()
+
+	code := http.StatusOK
+	if status.Status == healthFailing {
+		code = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	_ = json.NewEncoder(w).Encode(status)
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
@@ -0,0 +1,149 @@
+// Command goitunes-collector fetches App Store charts on a schedule and writes
+// their snapshots to a sink: NDJSON on stdout or a file, one JSON file per
+// snapshot, or a SQLite chart store.
+//
+// Usage:
+//
+//	goitunes-collector -config collector.yaml
+//
+// See config.example.yaml for the configuration. The collector stops on SIGINT
+// or SIGTERM after finishing the charts it is fetching.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultShutdownTimeout = 30 * time.Second
+	healthReadTimeout      = 5 * time.Second
+)
+
+func main() {
+	configPath := flag.String("config", "collector.yaml", "path to the YAML or JSON config file")
+	once := flag.Bool("once", false, "collect the charts once and exit instead of following the schedule")
+	shutdownTimeout := flag.Duration("shutdown-timeout", defaultShutdownTimeout,
+		"time to wait for charts being fetched on shutdown")
+
+	flag.Parse()
+
+	// Snapshots may go to stdout, so logs go to stderr.
+	log.SetOutput(os.Stderr)
+
+	if err := run(*configPath, *once, *shutdownTimeout); err != nil {
+		log.Fatalf("goitunes-collector: %v", err)
+	}
+}
+
+func run(configPath string, once bool, shutdownTimeout time.Duration) error {
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	sched, err := parseSchedule(cfg.Schedule)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	out, err := newSink(ctx, cfg.Sink)
+	if err != nil {
+		return err
+	}
+
+	c, err := newCollector(cfg, out)
+	if err != nil {
+		_ = out.close()
+
+		return err
+	}
+
+	defer func() {
+		if closeErr := c.close(); closeErr != nil {
+			log.Printf("close sink: %v", closeErr)
+		}
+	}()
+
+	if once {
+		c.collect(ctx)
+
+		return nil
+	}
+
+	server := startHealthServer(cfg.HealthAddr, c.health)
+
+	log.Printf("collecting %d charts on schedule %q", len(c.jobs), cfg.Schedule)
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- c.run(ctx, sched)
+	}()
+
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		log.Printf("shutting down")
+
+		select {
+		case err = <-done:
+		case <-time.After(shutdownTimeout):
+			err = errShutdownTimeout
+		}
+	}
+
+	shutdownHealthServer(server, shutdownTimeout)
+
+	return err
+}
+
+// startHealthServer serves the health endpoint on addr at /healthz; it returns
+// nil when addr is empty.
+func startHealthServer(addr string, status *health) *http.Server {
+	if addr == "" {
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /healthz", status)
+
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: healthReadTimeout,
+	}
+
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("health endpoint: %v", err)
+		}
+	}()
+
+	log.Printf("health endpoint listening on %s/healthz", addr)
+
+	return server
+}
+
+func shutdownHealthServer(server *http.Server, timeout time.Duration) {
+	if server == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if err := server.Shutdown(ctx); err != nil {
+		log.Printf("health endpoint shutdown: %v", err)
+	}
+}
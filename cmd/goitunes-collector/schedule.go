@@ -0,0 +1,211 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var errInvalidSchedule = errors.New("invalid schedule")
+
+// schedule returns the next run time after a given time.
+type schedule interface {
+	next(after time.Time) time.Time
+}
+
+// everySchedule runs at a fixed interval, e.g. "@every 30m".
+type everySchedule struct {
+	interval time.Duration
+}
+
+func (s everySchedule) next(after time.Time) time.Time {
+	return after.Add(s.interval)
+}
+
+// cronSchedule runs at the minutes matching a five-field cron expression.
+// Each field is a bit set of the allowed values.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+
+	// domAny and dowAny mark day fields given as "*". When both day fields are
+	// restricted a day matches either of them, as in cron.
+	domAny, dowAny bool
+}
+
+// cronField describes the allowed range of a cron field.
+type cronField struct {
+	name     string
+	min, max int
+}
+
+//nolint:gochecknoglobals // cron field ranges are acceptable as a global variable
+var cronFields = [5]cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7}, // 7 is Sunday, like 0
+}
+
+//nolint:gochecknoglobals // cron descriptors are acceptable as a global variable
+var cronDescriptors = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+}
+
+// maxScheduleSearch bounds the search for the next run of a cron schedule,
+// so expressions such as "0 0 31 2 *" that never match do not loop forever.
+const maxScheduleSearch = 5 * 366 * 24 * time.Hour
+
+// parseSchedule parses a five-field cron expression, a descriptor such as
+// "@daily", or "@every <duration>".
+func parseSchedule(spec string) (schedule, error) {
+	spec = strings.TrimSpace(spec)
+
+	if interval, ok := strings.CutPrefix(spec, "@every "); ok {
+		d, err := time.ParseDuration(strings.TrimSpace(interval))
+		if err != nil || d < time.Second {
+			return nil, fmt.Errorf("%w: %q: interval must be a duration of at least 1s", errInvalidSchedule, spec)
+		}
+
+		return everySchedule{interval: d}, nil
+	}
+
+	if expr, ok := cronDescriptors[spec]; ok {
+		spec = expr
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf("%w: %q: expected %d fields", errInvalidSchedule, spec, len(cronFields))
+	}
+
+	var sets [5]uint64
+
+	for i, field := range fields {
+		set, err := parseCronField(field, cronFields[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %w", errInvalidSchedule, spec, err)
+		}
+
+		sets[i] = set
+	}
+
+	// Fold Sunday as 7 into Sunday as 0.
+	if sets[4]&(1<<7) != 0 {
+		sets[4] = sets[4]&^(1<<7) | 1
+	}
+
+	return &cronSchedule{
+		minute: sets[0],
+		hour:   sets[1],
+		dom:    sets[2],
+		month:  sets[3],
+		dow:    sets[4],
+		domAny: fields[2] == "*",
+		dowAny: fields[4] == "*",
+	}, nil
+}
+
+// parseCronField parses a comma separated list of "*", values and ranges,
+// each with an optional "/step".
+func parseCronField(field string, bounds cronField) (uint64, error) {
+	var set uint64
+
+	for part := range strings.SplitSeq(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+
+		if hasStep {
+			n, err := strconv.Atoi(stepPart)
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("invalid step %q in %s field", stepPart, bounds.name)
+			}
+
+			step = n
+		}
+
+		low, high := bounds.min, bounds.max
+
+		if rangePart != "*" {
+			lowPart, highPart, isRange := strings.Cut(rangePart, "-")
+
+			var err error
+
+			if low, err = parseCronValue(lowPart, bounds); err != nil {
+				return 0, err
+			}
+
+			high = low
+
+			switch {
+			case isRange:
+				if high, err = parseCronValue(highPart, bounds); err != nil {
+					return 0, err
+				}
+			case hasStep:
+				high = bounds.max
+			}
+
+			if low > high {
+				return 0, fmt.Errorf("invalid range %q in %s field", rangePart, bounds.name)
+			}
+		}
+
+		for v := low; v <= high; v += step {
+			set |= 1 << v
+		}
+	}
+
+	return set, nil
+}
+
+func parseCronValue(value string, bounds cronField) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < bounds.min || n > bounds.max {
+		return 0, fmt.Errorf("%s must be between %d and %d, got %q", bounds.name, bounds.min, bounds.max, value)
+	}
+
+	return n, nil
+}
+
+// next returns the first matching minute after after, in after's location,
+// or the zero time if the expression never matches.
+func (s *cronSchedule) next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxScheduleSearch)
+
+	for t.Before(limit) {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+
+	if s.domAny || s.dowAny {
+		return domMatch && dowMatch
+	}
+
+	return domMatch || dowMatch
+}
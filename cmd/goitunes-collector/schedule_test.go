@@ -0,0 +1,72 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSchedule_Next(t *testing.T) {
+	t.Parallel()
+
+	// Friday, 1 March 2024 10:17:30 UTC.
+	after := time.Date(2024, 3, 1, 10, 17, 30, 0, time.UTC)
+
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{spec: "* * * * *", want: time.Date(2024, 3, 1, 10, 18, 0, 0, time.UTC)},
+		{spec: "0 * * * *", want: time.Date(2024, 3, 1, 11, 0, 0, 0, time.UTC)},
+		{spec: "@hourly", want: time.Date(2024, 3, 1, 11, 0, 0, 0, time.UTC)},
+		{spec: "*/15 * * * *", want: time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC)},
+		{spec: "5,45 9-11 * * *", want: time.Date(2024, 3, 1, 10, 45, 0, 0, time.UTC)},
+		{spec: "30 6 * * *", want: time.Date(2024, 3, 2, 6, 30, 0, 0, time.UTC)},
+		{spec: "@daily", want: time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 * * 1-5", want: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 12 * * 7", want: time.Date(2024, 3, 3, 12, 0, 0, 0, time.UTC)},
+		{spec: "0 0 29 2 *", want: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// Both day fields restricted: the 15th or any Monday.
+		{spec: "0 0 15 * 1", want: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{spec: "@every 90m", want: after.Add(90 * time.Minute)},
+		{spec: "0 0 31 2 *", want: time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			t.Parallel()
+
+			sched, err := parseSchedule(tt.spec)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if got := sched.next(after); !got.Equal(tt.want) {
+				t.Errorf("Expected next run %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseSchedule_Invalid(t *testing.T) {
+	t.Parallel()
+
+	specs := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"a * * * *",
+		"@every 1ms",
+		"@every soon",
+		"@yearly",
+	}
+
+	for _, spec := range specs {
+		if _, err := parseSchedule(spec); err == nil {
+			t.Errorf("Expected error for schedule %q", spec)
+		}
+	}
+}
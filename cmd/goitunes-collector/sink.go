@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+	"github.com/truewebber/goitunes/v2/pkg/goitunes/sqlitestore"
+)
+
+// sink fetches charts and stores their snapshots.
+type sink interface {
+	collect(
+		ctx context.Context,
+		client *goitunes.Client,
+		genre goitunes.Genre,
+		chartType goitunes.ChartType,
+		options ...goitunes.Top200Option,
+	) (*goitunes.ChartSnapshot, error)
+	close() error
+}
+
+// snapshotWriter stores snapshots taken with ChartService.Snapshot.
+type snapshotWriter interface {
+	write(snapshot *goitunes.ChartSnapshot) error
+	close() error
+}
+
+// writerSink is a sink that takes snapshots with ChartService.Snapshot and
+// hands them to a snapshotWriter.
+type writerSink struct {
+	snapshotWriter
+}
+
+func (s writerSink) collect(
+	ctx context.Context,
+	client *goitunes.Client,
+	genre goitunes.Genre,
+	chartType goitunes.ChartType,
+	options ...goitunes.Top200Option,
+) (*goitunes.ChartSnapshot, error) {
+	snapshot, err := client.Charts().Snapshot(ctx, genre, chartType, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.write(snapshot); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+const (
+	snapshotDirPerm  = 0o755
+	snapshotFilePerm = 0o644
+	// snapshotTimeFormat names snapshot files; it sorts chronologically.
+	snapshotTimeFormat = "20060102T150405Z"
+)
+
+// newSink creates the sink selected by cfg.
+func newSink(ctx context.Context, cfg sinkConfig) (sink, error) {
+	switch cfg.Type {
+	case sinkTypeJSON:
+		if err := os.MkdirAll(cfg.Path, snapshotDirPerm); err != nil {
+			return nil, fmt.Errorf("failed to create snapshot directory: %w", err)
+		}
+
+		return writerSink{&jsonFileWriter{dir: cfg.Path}}, nil
+	case sinkTypeSQLite:
+		store, err := sqlitestore.Open(ctx, cfg.Path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open sqlite sink: %w", err)
+		}
+
+		return &sqliteSink{store: store}, nil
+	default:
+		if cfg.Path == "" {
+			return writerSink{&ndjsonWriter{out: os.Stdout}}, nil
+		}
+
+		file, err := os.OpenFile(cfg.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, snapshotFilePerm)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open ndjson sink: %w", err)
+		}
+
+		return writerSink{&ndjsonWriter{out: file, closer: file}}, nil
+	}
+}
+
+// ndjsonWriter writes one snapshot per line.
+type ndjsonWriter struct {
+	out    io.Writer
+	closer io.Closer // nil for stdout
+
+	mu sync.Mutex
+}
+
+func (s *ndjsonWriter) write(snapshot *goitunes.ChartSnapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err = s.out.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	return nil
+}
+
+func (s *ndjsonWriter) close() error {
+	if s.closer == nil {
+		return nil
+	}
+
+	if err := s.closer.Close(); err != nil {
+		return fmt.Errorf("failed to close ndjson sink: %w", err)
+	}
+
+	return nil
+}
+
+// jsonFileWriter writes every snapshot to its own file,
+// <dir>/<storefront>/<device>/<genre>-<chart type>-<timestamp>.json.
+type jsonFileWriter struct {
+	dir string
+}
+
+func (s *jsonFileWriter) write(snapshot *goitunes.ChartSnapshot) error {
+	dir := filepath.Join(s.dir, snapshot.Storefront, snapshot.Device)
+	if err := os.MkdirAll(dir, snapshotDirPerm); err != nil {
+		return fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+
+	name := fmt.Sprintf("%s-%s-%s.json",
+		snapshot.GenreID, snapshot.ChartType, snapshot.Timestamp.UTC().Format(snapshotTimeFormat))
+
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+
+	return writeFileAtomic(filepath.Join(dir, name), data)
+}
+
+func (s *jsonFileWriter) close() error {
+	return nil
+}
+
+// writeFileAtomic writes data to a temporary file and renames it to path, so
+// readers never see a partially written snapshot.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot file: %w", err)
+	}
+
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	if err = os.Chmod(tmp.Name(), snapshotFilePerm); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to rename snapshot file: %w", err)
+	}
+
+	return nil
+}
+
+// sqliteSink records charts into a SQLite chart store, where they can be
+// queried with goitunes.ChartHistoryService.
+type sqliteSink struct {
+	store *sqlitestore.Store
+}
+
+func (s *sqliteSink) collect(
+	ctx context.Context,
+	client *goitunes.Client,
+	genre goitunes.Genre,
+	chartType goitunes.ChartType,
+	options ...goitunes.Top200Option,
+) (*goitunes.ChartSnapshot, error) {
+	return client.ChartHistory(s.store).Record(ctx, genre, chartType, options...)
+}
+
+func (s *sqliteSink) close() error {
+	if err := s.store.Close(); err != nil {
+		return fmt.Errorf("failed to close sqlite sink: %w", err)
+	}
+
+	return nil
+}
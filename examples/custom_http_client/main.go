@@ -152,8 +152,8 @@ func demonstrateRetryClient() {
 
 	log.Println("\nTop 5 Free Apps:")
 
-	for i := range charts {
-		log.Printf("%d. %s", charts[i].Position, charts[i].App.Name)
+	for i := range charts.Items {
+		log.Printf("%d. %s", charts.Items[i].Position, charts.Items[i].App.Name)
 	}
 }
 
@@ -47,11 +47,17 @@ func demonstrateTop200Free(ctx context.Context, client *goitunes.Client) {
 		log.Fatalf("Failed to get top charts: %v", err)
 	}
 
-	displayCharts(charts, topFreeDisplayLimit, func(chart interface{}) {
+	displayCharts(charts.Items, topFreeDisplayLimit, func(chart interface{}) {
 		logChartItem(chart, func(pos int, appName, bundleID string, rating float64) {
 			log.Printf("%d. %s (%s) - Rating: %.1f", pos, appName, bundleID, rating)
 		})
 	})
+
+	log.Printf("Chart size: %d, unresolved positions: %d", charts.TotalCount, len(charts.Unresolved))
+
+	for _, unresolved := range charts.Unresolved {
+		log.Printf("  position %d (adamID %s): %s", unresolved.Position, unresolved.AdamID, unresolved.Reason)
+	}
 }
 
 func demonstrateTop1500Paid(ctx context.Context, client *goitunes.Client) {
@@ -68,7 +74,7 @@ func demonstrateTop1500Paid(ctx context.Context, client *goitunes.Client) {
 		log.Fatalf("Failed to get top 1500: %v", err)
 	}
 
-	displayCharts(charts1500, topPaidDisplayLimit, func(chart interface{}) {
+	displayCharts(charts1500.Items, topPaidDisplayLimit, func(chart interface{}) {
 		logChartItem(chart, func(pos int, _, bundleID string, _ float64) {
 			log.Printf("%d. %s - $%.2f %s", pos, bundleID, getPrice(chart), getCurrency(chart))
 		})
@@ -88,7 +94,7 @@ func demonstrateTopGrossing(ctx context.Context, client *goitunes.Client) {
 		log.Fatalf("Failed to get top grossing: %v", err)
 	}
 
-	displayCharts(topGrossing, len(topGrossing), func(chart interface{}) {
+	displayCharts(topGrossing.Items, len(topGrossing.Items), func(chart interface{}) {
 		logChartItem(chart, func(pos int, appName, bundleID string, _ float64) {
 			log.Printf("%d. %s - %s", pos, appName, bundleID)
 		})
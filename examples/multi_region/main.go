@@ -27,46 +27,44 @@ func compareAppPrices(ctx context.Context) {
 
 	log.Println("=== Comparing App Prices Across Regions ===")
 
-	for _, region := range regions {
-		compareAppPriceInRegion(ctx, region, bundleID)
-	}
-}
-
-func compareAppPriceInRegion(ctx context.Context, region, bundleID string) {
-	client, err := goitunes.New(region)
+	multi, err := goitunes.NewMultiRegion(regions)
 	if err != nil {
-		log.Printf("Failed to create client for %s: %v", region, err)
+		log.Printf("Failed to create multi-region client: %v", err)
 
 		return
 	}
 
-	apps, err := client.Applications().GetByBundleID(ctx, bundleID)
-	if err != nil {
-		log.Printf("Failed to get app for %s: %v", region, err)
+	results := multi.Applications().GetByBundleID(ctx, bundleID)
 
-		return
-	}
+	for _, region := range multi.Regions() {
+		result := results[region]
+		if result.Err != nil {
+			log.Printf("Failed to get app for %s: %v", region, result.Err)
 
-	if len(apps) == 0 {
-		log.Printf("%s: App not available", region)
+			continue
+		}
 
-		return
-	}
+		if len(result.Value) == 0 {
+			log.Printf("%s: App not available", region)
 
-	app := apps[0]
+			continue
+		}
 
-	// Format price directly - fields are exported and accessible
-	price := "Free"
-	if !app.IsFree {
-		price = fmt.Sprintf("%.2f %s", app.Price, app.Currency)
-	}
+		app := result.Value[0]
 
-	log.Printf("%s: %s - %s (Rating: %.1f)",
-		region,
-		app.Name,
-		price,
-		app.Rating,
-	)
+		// Format price directly - fields are exported and accessible
+		price := "Free"
+		if !app.IsFree {
+			price = fmt.Sprintf("%.2f %s", app.Price, app.Currency)
+		}
+
+		log.Printf("%s: %s - %s (Rating: %.1f)",
+			region,
+			app.Name,
+			price,
+			app.Rating,
+		)
+	}
 }
 
 func compareTopApps(ctx context.Context) {
@@ -74,38 +72,36 @@ func compareTopApps(ctx context.Context) {
 
 	log.Println("\n=== Top Apps in Different Regions ===")
 
-	for _, region := range regionsTop {
-		showTopAppsInRegion(ctx, region)
-	}
-}
-
-func showTopAppsInRegion(ctx context.Context, region string) {
-	client, err := goitunes.New(region)
+	multi, err := goitunes.NewMultiRegion(regionsTop, goitunes.WithRegionConcurrency(len(regionsTop)))
 	if err != nil {
-		log.Printf("Failed to create client for %s: %v", region, err)
+		log.Printf("Failed to create multi-region client: %v", err)
 
 		return
 	}
 
-	charts, err := client.Charts().GetTop200(
+	results := multi.Charts().GetTop200(
 		ctx,
 		goitunes.GenreAll,
 		goitunes.ChartTypeTopFree,
 		goitunes.WithRange(1, topAppsLimit),
 	)
-	if err != nil {
-		log.Printf("Failed to get charts for %s: %v", region, err)
 
-		return
-	}
+	for _, region := range multi.Regions() {
+		result := results[region]
+		if result.Err != nil {
+			log.Printf("Failed to get charts for %s: %v", region, result.Err)
 
-	log.Printf("Top 3 Free Apps in %s:", region)
+			continue
+		}
 
-	for i := range charts {
-		log.Printf("  %d. %s", charts[i].Position, charts[i].App.Name)
-	}
+		log.Printf("Top 3 Free Apps in %s:", region)
 
-	log.Println()
+		for i := range result.Value.Items {
+			log.Printf("  %d. %s", result.Value.Items[i].Position, result.Value.Items[i].App.Name)
+		}
+
+		log.Println()
+	}
 }
 
 func showSupportedRegions() {
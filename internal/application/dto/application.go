@@ -1,31 +1,118 @@
 package dto
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 // ApplicationDTO represents application data transfer object.
 type ApplicationDTO struct {
-	ReleaseDate      time.Time `json:"releaseDate"`
-	Description      string    `json:"description"`
-	Currency         string    `json:"currency"`
-	ArtistName       string    `json:"artistName"`
-	ArtistID         string    `json:"artistId"`
-	Version          string    `json:"version"`
-	GenreName        string    `json:"genreName"`
-	IconURL          string    `json:"iconUrl"`
-	Name             string    `json:"name"`
-	MinimumOSVersion string    `json:"minimumOsVersion"`
-	AdamID           string    `json:"adamId"`
-	BundleID         string    `json:"bundleId"`
-	GenreID          string    `json:"genreId"`
-	ScreenshotURLs   []string  `json:"screenshotUrls"`
-	DeviceFamilies   []string  `json:"deviceFamilies"`
-	RatingCount      int       `json:"ratingCount"`
-	FileSize         int64     `json:"fileSize"`
-	Rating           float64   `json:"rating"`
-	Price            float64   `json:"price"`
-	VersionID        int64     `json:"versionId"`
-	IsFree           bool      `json:"isFree"`
-	IsUniversal      bool      `json:"isUniversal"`
+	ReleaseDate      time.Time         `json:"releaseDate"`
+	Description      string            `json:"description"`
+	ReleaseNotes     string            `json:"releaseNotes"`
+	Currency         string            `json:"currency"`
+	ArtistName       string            `json:"artistName"`
+	ArtistID         string            `json:"artistId"`
+	Version          string            `json:"version"`
+	GenreName        string            `json:"genreName"`
+	IconURL          string            `json:"iconUrl"`
+	Name             string            `json:"name"`
+	MinimumOSVersion string            `json:"minimumOsVersion"`
+	AdamID           string            `json:"adamId"`
+	BundleID         string            `json:"bundleId"`
+	GenreID          string            `json:"genreId"`
+	Locale           string            `json:"locale"`
+	Copyright        string            `json:"copyright"`
+	ScreenshotURLs   []string          `json:"screenshotUrls"`
+	DeviceFamilies   []string          `json:"deviceFamilies"`
+	Platforms        []string          `json:"platforms"` // e.g. "iphone", "ipad", "mac", "appletv", "watch", "vision"
+	Genres           []GenreDTO        `json:"genres"`
+	Offers           []OfferDTO        `json:"offers"`
+	FileSizeByDevice map[string]int64  `json:"fileSizeByDevice"`
+	Artwork          ArtworkDTO        `json:"artwork"`
+	ContentRating    *ContentRatingDTO `json:"contentRating,omitempty"`
+	RatingCount      int               `json:"ratingCount"`
+	FileSize         int64             `json:"fileSize"`
+	Rating           float64           `json:"rating"`
+	Price            float64           `json:"price"`
+	VersionID        int64             `json:"versionId"`
+	IsFree           bool              `json:"isFree"`
+	IsUniversal      bool              `json:"isUniversal"`
+}
+
+// SupportsPlatform reports whether the application runs on the platform with name, e.g. "mac".
+func (a *ApplicationDTO) SupportsPlatform(name string) bool {
+	return slices.Contains(a.Platforms, name)
+}
+
+// SupportsMac reports whether the application runs on Mac.
+func (a *ApplicationDTO) SupportsMac() bool { return a.SupportsPlatform("mac") }
+
+// SupportsTV reports whether the application runs on Apple TV.
+func (a *ApplicationDTO) SupportsTV() bool { return a.SupportsPlatform("appletv") }
+
+// SupportsWatch reports whether the application runs on Apple Watch.
+func (a *ApplicationDTO) SupportsWatch() bool { return a.SupportsPlatform("watch") }
+
+// SupportsVision reports whether the application runs on Apple Vision Pro.
+func (a *ApplicationDTO) SupportsVision() bool { return a.SupportsPlatform("vision") }
+
+// GenreDTO represents a genre data transfer object.
+type GenreDTO struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+// OfferDTO represents an offer data transfer object.
+type OfferDTO struct {
+	Type           string          `json:"type"`
+	PriceFormatted string          `json:"priceFormatted"`
+	Currency       string          `json:"currency"`
+	BuyParams      string          `json:"buyParams"`
+	Version        string          `json:"version"`
+	Assets         []OfferAssetDTO `json:"assets"`
+	Price          float64         `json:"price"`
+	VersionID      int64           `json:"versionId"`
+}
+
+// OfferAssetDTO represents a downloadable asset of an offer.
+type OfferAssetDTO struct {
+	Flavor string `json:"flavor"`
+	Size   int64  `json:"size"`
+}
+
+// VersionDTO represents a released version of an application.
+type VersionDTO struct {
+	ReleaseDate  time.Time `json:"releaseDate"`
+	Version      string    `json:"version"`
+	ReleaseNotes string    `json:"releaseNotes"`
+}
+
+// ArtworkDTO represents an image template with {w}, {h}, {c} and {f} placeholders.
+type ArtworkDTO struct {
+	URLTemplate string `json:"urlTemplate"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+}
+
+// ContentRatingDTO represents the age rating of an application.
+type ContentRatingDTO struct {
+	System     string   `json:"system"`
+	Label      string   `json:"label"`
+	Advisories []string `json:"advisories"`
+	Rank       int      `json:"rank"`
+	MinimumAge int      `json:"minimumAge"` // -1 when the label has no age, e.g. "Not yet rated"
+}
+
+// InAppPurchaseDTO represents an in-app purchase of an application.
+type InAppPurchaseDTO struct {
+	AdamID         string  `json:"adamId"`
+	Name           string  `json:"name"`
+	Type           string  `json:"type"`
+	PriceFormatted string  `json:"priceFormatted"`
+	Currency       string  `json:"currency"`
+	Price          float64 `json:"price"`
 }
 
 // ChartItemDTO represents a chart item data transfer object.
@@ -34,6 +121,13 @@ type ChartItemDTO struct {
 	Position int            `json:"position"`
 }
 
+// UnresolvedChartItemDTO represents a chart position whose application could not be resolved.
+type UnresolvedChartItemDTO struct {
+	AdamID   string `json:"adamId"`
+	Reason   string `json:"reason"`
+	Position int    `json:"position"`
+}
+
 // DownloadInfoDTO represents download information data transfer object.
 type DownloadInfoDTO struct {
 	BundleID    string            `json:"bundleId"`
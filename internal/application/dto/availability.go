@@ -0,0 +1,44 @@
+package dto
+
+// AvailabilityDTO represents in which regions an application is sold.
+type AvailabilityDTO struct {
+	ID              string                  `json:"id"`              // Requested Adam ID or Bundle ID
+	MajorityVersion string                  `json:"majorityVersion"` // Version sold in most regions
+	Regions         []RegionAvailabilityDTO `json:"regions"`         // Ordered by region code
+}
+
+// RegionAvailabilityDTO represents the availability of an application in a single region.
+type RegionAvailabilityDTO struct {
+	Region          string       `json:"region"`
+	Status          LookupStatus `json:"status"`
+	Currency        string       `json:"currency,omitempty"`
+	Version         string       `json:"version,omitempty"`
+	Error           string       `json:"error,omitempty"` // Set when the region could not be queried
+	Price           float64      `json:"price"`
+	Available       bool         `json:"available"`
+	VersionMismatch bool         `json:"versionMismatch"` // Version differs from MajorityVersion
+}
+
+// Region returns the availability in a region.
+func (a *AvailabilityDTO) Region(region string) (RegionAvailabilityDTO, bool) {
+	for _, availability := range a.Regions {
+		if availability.Region == region {
+			return availability, true
+		}
+	}
+
+	return RegionAvailabilityDTO{}, false
+}
+
+// AvailableRegions returns the regions the application is sold in.
+func (a *AvailabilityDTO) AvailableRegions() []string {
+	regions := make([]string, 0, len(a.Regions))
+
+	for _, availability := range a.Regions {
+		if availability.Available {
+			regions = append(regions, availability.Region)
+		}
+	}
+
+	return regions
+}
@@ -0,0 +1,43 @@
+package dto
+
+import "time"
+
+// ChartSnapshotDTO represents a chart captured at a point in time.
+type ChartSnapshotDTO struct {
+	Timestamp  time.Time                `json:"timestamp"`
+	Storefront string                   `json:"storefront"`
+	GenreID    string                   `json:"genreId"`
+	ChartType  string                   `json:"chartType"`
+	Device     string                   `json:"device,omitempty"`     // Platform name, e.g. "iphone"
+	AgeBand    string                   `json:"ageBand,omitempty"`    // Kids age band the chart is limited to
+	MaxAge     int                      `json:"maxAge,omitempty"`     // Age rating filter applied to the chart
+	Items      []ChartItemDTO           `json:"items"`                // Ordered by position
+	Unresolved []UnresolvedChartItemDTO `json:"unresolved,omitempty"` // Positions without application info
+}
+
+// ChartDiffDTO represents the changes between two snapshots of the same chart.
+type ChartDiffDTO struct {
+	From      time.Time          `json:"from"`
+	To        time.Time          `json:"to"`
+	New       []ChartItemDTO     `json:"new"`     // Entries absent from the previous snapshot
+	Dropped   []ChartItemDTO     `json:"dropped"` // Entries absent from the current snapshot
+	MovedUp   []ChartMovementDTO `json:"movedUp"`
+	MovedDown []ChartMovementDTO `json:"movedDown"`
+	Unchanged int                `json:"unchanged"`
+}
+
+// ChartMovementDTO represents a rank change of an application between two snapshots.
+type ChartMovementDTO struct {
+	App              ApplicationDTO `json:"app"`
+	PreviousPosition int            `json:"previousPosition"`
+	Position         int            `json:"position"`
+	Delta            int            `json:"delta"` // Positive when the app moved up
+}
+
+// RankPointDTO represents the position of an application in a tracked chart at a point in time.
+type RankPointDTO struct {
+	CapturedAt time.Time `json:"capturedAt"`
+	Currency   string    `json:"currency"`
+	Position   int       `json:"position"`
+	Price      float64   `json:"price"`
+}
@@ -0,0 +1,91 @@
+package dto
+
+import "time"
+
+// GenreCatalogDTO represents the App Store genre tree of a storefront.
+// It is JSON-serialisable, so a catalog can be cached and loaded later.
+type GenreCatalogDTO struct {
+	RefreshedAt time.Time      `json:"refreshedAt"`
+	Region      string         `json:"region"`
+	Language    string         `json:"language"`
+	Platform    string         `json:"platform,omitempty"` // Platform name; Mac catalogs are rooted at genre 39
+	Genres      []GenreNodeDTO `json:"genres"`             // Parents before their subgenres
+}
+
+// GenreNodeDTO represents a genre of the genre tree.
+type GenreNodeDTO struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	ParentID string `json:"parentId,omitempty"` // Empty for the root genre
+}
+
+// Genre returns the genre with id.
+func (c *GenreCatalogDTO) Genre(id string) (GenreNodeDTO, bool) {
+	for _, genre := range c.Genres {
+		if genre.ID == id {
+			return genre, true
+		}
+	}
+
+	return GenreNodeDTO{}, false
+}
+
+// Name returns the name of the genre with id, or an empty string if the catalog does not know it.
+func (c *GenreCatalogDTO) Name(id string) string {
+	genre, _ := c.Genre(id)
+
+	return genre.Name
+}
+
+// Roots returns the genres without a parent.
+func (c *GenreCatalogDTO) Roots() []GenreNodeDTO {
+	var roots []GenreNodeDTO
+
+	for _, genre := range c.Genres {
+		if genre.ParentID == "" {
+			roots = append(roots, genre)
+		}
+	}
+
+	return roots
+}
+
+// Parent returns the parent of the genre with id. It reports false for root
+// and unknown genres.
+func (c *GenreCatalogDTO) Parent(id string) (GenreNodeDTO, bool) {
+	genre, ok := c.Genre(id)
+	if !ok || genre.ParentID == "" {
+		return GenreNodeDTO{}, false
+	}
+
+	return c.Genre(genre.ParentID)
+}
+
+// Subgenres returns the direct subgenres of the genre with id, in catalog order.
+func (c *GenreCatalogDTO) Subgenres(id string) []GenreNodeDTO {
+	var subgenres []GenreNodeDTO
+
+	for _, genre := range c.Genres {
+		if genre.ParentID == id && id != "" {
+			subgenres = append(subgenres, genre)
+		}
+	}
+
+	return subgenres
+}
+
+// Path returns the genre with id and its ancestors, root first,
+// e.g. App Store → Games → Puzzle.
+func (c *GenreCatalogDTO) Path(id string) []GenreNodeDTO {
+	var path []GenreNodeDTO
+
+	for genre, ok := c.Genre(id); ok; genre, ok = c.Parent(genre.ID) {
+		path = append([]GenreNodeDTO{genre}, path...)
+
+		if len(path) > len(c.Genres) {
+			return nil // The parent links form a cycle
+		}
+	}
+
+	return path
+}
@@ -0,0 +1,26 @@
+package dto
+
+// PriceComparisonDTO represents application prices across regions converted to one currency.
+type PriceComparisonDTO struct {
+	BaseCurrency string                  `json:"baseCurrency"`
+	Apps         []AppPriceComparisonDTO `json:"apps"` // Ordered by Adam ID
+}
+
+// AppPriceComparisonDTO represents the regional prices of a single application.
+type AppPriceComparisonDTO struct {
+	AdamID        string           `json:"adamId"`
+	BundleID      string           `json:"bundleId"`
+	Name          string           `json:"name"`
+	Cheapest      string           `json:"cheapest"`      // Region with the lowest converted price
+	MostExpensive string           `json:"mostExpensive"` // Region with the highest converted price
+	Prices        []RegionPriceDTO `json:"prices"`        // Ordered by converted price, unconverted last
+}
+
+// RegionPriceDTO represents an application price in a single region.
+type RegionPriceDTO struct {
+	Region    string  `json:"region"`
+	Currency  string  `json:"currency"`
+	Error     string  `json:"error,omitempty"` // Set when the price could not be converted
+	Price     float64 `json:"price"`
+	BasePrice float64 `json:"basePrice"`
+}
@@ -0,0 +1,22 @@
+package dto
+
+// PrivacyDetailsDTO represents the App Privacy label of an application.
+type PrivacyDetailsDTO struct {
+	AdamID           string              `json:"adamId"`
+	PolicyURL        string              `json:"policyUrl"`
+	ManageChoicesURL string              `json:"manageChoicesUrl,omitempty"`
+	Sections         []PrivacySectionDTO `json:"sections"`
+}
+
+// PrivacySectionDTO represents one section of the App Privacy label.
+type PrivacySectionDTO struct {
+	Type       string              `json:"type"`       // e.g. "linked_to_you"
+	Categories []string            `json:"categories"` // e.g. "contact_info"
+	Purposes   []PrivacyPurposeDTO `json:"purposes"`
+}
+
+// PrivacyPurposeDTO represents the data categories collected for a purpose.
+type PrivacyPurposeDTO struct {
+	Purpose    string   `json:"purpose"` // e.g. "analytics"
+	Categories []string `json:"categories"`
+}
@@ -9,6 +9,7 @@ type GetTopChartsRequest struct {
 	Limit      int    // Number of results
 	MaxResults int    // For Top1500: page size
 	Page       int    // For Top1500: page number (0-based)
+	MaxAge     int    // Optional: keep only apps rated for this age; unrated apps are dropped
 }
 
 // GetApplicationInfoRequest represents a request to get application info.
@@ -17,6 +18,12 @@ type GetApplicationInfoRequest struct {
 	BundleIDs []string
 }
 
+// GetByDeveloperRequest represents a request to get the applications of a developer.
+type GetByDeveloperRequest struct {
+	ArtistID string
+	Limit    int // Maximum number of applications, at most 200; the artist lookup cannot be paged
+}
+
 // GetRatingRequest represents a request to get rating information.
 type GetRatingRequest struct {
 	AdamID  string
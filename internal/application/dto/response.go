@@ -2,8 +2,10 @@ package dto
 
 // GetTopChartsResponse represents the response for getting top charts.
 type GetTopChartsResponse struct {
-	Items      []ChartItemDTO `json:"items"`
-	TotalCount int            `json:"totalCount"`
+	Items      []ChartItemDTO           `json:"items"`
+	Unresolved []UnresolvedChartItemDTO `json:"unresolved"`
+	TotalCount int                      `json:"totalCount"` // Number of entries in the whole chart; the page length for Top1500
+	Filtered   int                      `json:"filtered"`   // Number of items dropped by the age filter
 }
 
 // GetApplicationInfoResponse represents the response for getting application info.
@@ -11,17 +13,98 @@ type GetApplicationInfoResponse struct {
 	Applications []ApplicationDTO `json:"applications"`
 }
 
+// LookupStatus describes the outcome of looking up a single identifier.
+type LookupStatus string
+
+const (
+	// LookupStatusFound means the application was found and is sold in the storefront.
+	LookupStatusFound LookupStatus = "found"
+
+	// LookupStatusNotFound means the storefront returned nothing for the identifier.
+	LookupStatusNotFound LookupStatus = "not_found"
+
+	// LookupStatusNotAvailable means the application exists but is not sold in the storefront.
+	LookupStatusNotAvailable LookupStatus = "not_available"
+
+	// LookupStatusFailed means the batch containing the identifier could not be fetched.
+	LookupStatusFailed LookupStatus = "failed"
+)
+
+// LookupResultDTO holds the outcome of looking up one requested identifier.
+type LookupResultDTO struct {
+	App    *ApplicationDTO `json:"app,omitempty"`
+	ID     string          `json:"id"`
+	Status LookupStatus    `json:"status"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// LookupApplicationsResponse represents per-identifier lookup results in request order.
+type LookupApplicationsResponse struct {
+	Results []LookupResultDTO `json:"results"`
+}
+
 // GetRatingResponse represents the response for getting rating info.
 type GetRatingResponse struct {
 	Rating      float64 `json:"rating"`
 	RatingCount int     `json:"ratingCount"`
 }
 
+// GetInAppPurchasesResponse represents the in-app purchases of an application in a storefront.
+type GetInAppPurchasesResponse struct {
+	AdamID         string             `json:"adamId"`
+	Storefront     string             `json:"storefront"`
+	InAppPurchases []InAppPurchaseDTO `json:"inAppPurchases"`
+}
+
+// GetByDeveloperResponse represents a developer and their applications in a storefront.
+type GetByDeveloperResponse struct {
+	Developer    DeveloperDTO     `json:"developer"`
+	Applications []ApplicationDTO `json:"applications"`
+}
+
+// DeveloperDTO represents a developer profile.
+type DeveloperDTO struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+	AppCount int    `json:"appCount"` // Number of applications the developer lists, up to the request limit
+}
+
+// GetRelatedResponse represents the applications related to an application.
+type GetRelatedResponse struct {
+	AdamID   string              `json:"adamId"`
+	Sections []RelatedSectionDTO `json:"sections"`
+}
+
+// RelatedSection labels the section of an application page related applications are listed in.
+type RelatedSection string
+
+const (
+	// RelatedSectionMoreByDeveloper holds other applications of the same developer.
+	RelatedSectionMoreByDeveloper RelatedSection = "more_by_developer"
+
+	// RelatedSectionYouMightAlsoLike holds applications customers also bought.
+	RelatedSectionYouMightAlsoLike RelatedSection = "you_might_also_like"
+)
+
+// RelatedSectionDTO represents the applications of one section of an application page.
+type RelatedSectionDTO struct {
+	Section      RelatedSection   `json:"section"`
+	Applications []ApplicationDTO `json:"applications"`
+}
+
+// GetVersionHistoryResponse represents the version history of an application.
+type GetVersionHistoryResponse struct {
+	AdamID   string       `json:"adamId"`
+	Versions []VersionDTO `json:"versions"` // Oldest first
+}
+
 // AuthenticateResponse represents the authentication response.
 type AuthenticateResponse struct {
 	AppleID       string `json:"appleId"`
 	PasswordToken string `json:"passwordToken"`
 	DSID          string `json:"dsid"`
+	Pod           int    `json:"pod,omitempty"` // Host prefix discovered during login
 	Authenticated bool   `json:"authenticated"`
 }
 
@@ -3,6 +3,7 @@ package mapper
 import (
 	"github.com/truewebber/goitunes/v2/internal/application/dto"
 	"github.com/truewebber/goitunes/v2/internal/domain/entity"
+	"github.com/truewebber/goitunes/v2/internal/domain/valueobject"
 )
 
 // ApplicationMapper handles mapping between domain entities and DTOs.
@@ -31,11 +32,20 @@ func (m *ApplicationMapper) ToDTO(app *entity.Application) dto.ApplicationDTO {
 		GenreID:          app.GenreID(),
 		GenreName:        app.GenreName(),
 		DeviceFamilies:   app.DeviceFamilies(),
+		Platforms:        m.platformNames(app.DeviceFamilySet()),
 		FileSize:         app.FileSize(),
 		MinimumOSVersion: app.MinimumOSVersion(),
 		Description:      app.Description(),
+		ReleaseNotes:     app.ReleaseNotes(),
 		IconURL:          app.IconURL(),
 		ScreenshotURLs:   app.ScreenshotURLs(),
+		Locale:           app.Locale(),
+		Copyright:        app.Copyright(),
+		Genres:           m.genresToDTO(app.Genres()),
+		Offers:           m.offersToDTO(app.Offers()),
+		FileSizeByDevice: app.FileSizeByDevice(),
+		Artwork:          m.artworkToDTO(app.Artwork()),
+		ContentRating:    m.contentRatingToDTO(app.ContentRating()),
 		IsFree:           app.IsFree(),
 		IsUniversal:      app.IsUniversal(),
 	}
@@ -69,6 +79,127 @@ func (m *ApplicationMapper) ChartItemsToDTOList(items []*entity.ChartItem) []dto
 	return dtos
 }
 
+// ChartSnapshotToDTO maps a ChartSnapshot entity to ChartSnapshotDTO.
+func (m *ApplicationMapper) ChartSnapshotToDTO(snapshot *entity.ChartSnapshot) dto.ChartSnapshotDTO {
+	return dto.ChartSnapshotDTO{
+		Timestamp:  snapshot.CapturedAt,
+		Storefront: snapshot.Key.Storefront,
+		GenreID:    snapshot.Key.GenreID,
+		ChartType:  string(snapshot.Key.ChartType),
+		Device:     snapshot.Key.Device,
+		AgeBand:    snapshot.Key.AgeBand,
+		MaxAge:     snapshot.Key.MaxAge,
+		Items:      m.ChartItemsToDTOList(snapshot.Chart.Items()),
+		Unresolved: m.UnresolvedChartItemsToDTOList(snapshot.Chart.Errors()),
+	}
+}
+
+// UnresolvedChartItemsToDTOList maps a list of ChartItemError entities to DTOs.
+func (m *ApplicationMapper) UnresolvedChartItemsToDTOList(errs []*entity.ChartItemError) []dto.UnresolvedChartItemDTO {
+	dtos := make([]dto.UnresolvedChartItemDTO, 0, len(errs))
+	for _, itemErr := range errs {
+		dtos = append(dtos, dto.UnresolvedChartItemDTO{
+			AdamID:   itemErr.AdamID,
+			Position: itemErr.Position,
+			Reason:   itemErr.Err.Error(),
+		})
+	}
+
+	return dtos
+}
+
+// InAppPurchasesToDTOList maps in-app purchases to DTOs.
+func (m *ApplicationMapper) InAppPurchasesToDTOList(purchases []entity.InAppPurchase) []dto.InAppPurchaseDTO {
+	dtos := make([]dto.InAppPurchaseDTO, 0, len(purchases))
+	for _, purchase := range purchases {
+		dtos = append(dtos, dto.InAppPurchaseDTO{
+			AdamID:         purchase.AdamID,
+			Name:           purchase.Name,
+			Type:           purchase.Type.String(),
+			PriceFormatted: purchase.PriceFormatted,
+			Currency:       purchase.Currency,
+			Price:          purchase.Price,
+		})
+	}
+
+	return dtos
+}
+
+// VersionHistoryToDTOList maps released versions to DTOs.
+func (m *ApplicationMapper) VersionHistoryToDTOList(versions []entity.Version) []dto.VersionDTO {
+	dtos := make([]dto.VersionDTO, 0, len(versions))
+	for _, version := range versions {
+		dtos = append(dtos, dto.VersionDTO{
+			ReleaseDate:  version.ReleaseDate,
+			Version:      version.Version,
+			ReleaseNotes: version.ReleaseNotes,
+		})
+	}
+
+	return dtos
+}
+
+// PrivacyDetailsToDTO maps the App Privacy label of the application with adamID to a DTO.
+func (m *ApplicationMapper) PrivacyDetailsToDTO(adamID string, details *entity.PrivacyDetails) dto.PrivacyDetailsDTO {
+	sections := make([]dto.PrivacySectionDTO, 0, len(details.Sections))
+	for _, section := range details.Sections {
+		purposes := make([]dto.PrivacyPurposeDTO, 0, len(section.Purposes))
+		for _, purpose := range section.Purposes {
+			purposes = append(purposes, dto.PrivacyPurposeDTO{
+				Purpose:    string(purpose.Purpose),
+				Categories: m.privacyCategoriesToDTO(purpose.Categories),
+			})
+		}
+
+		sections = append(sections, dto.PrivacySectionDTO{
+			Type:       string(section.Type),
+			Categories: m.privacyCategoriesToDTO(section.Categories),
+			Purposes:   purposes,
+		})
+	}
+
+	return dto.PrivacyDetailsDTO{
+		AdamID:           adamID,
+		PolicyURL:        details.PolicyURL,
+		ManageChoicesURL: details.ManageChoicesURL,
+		Sections:         sections,
+	}
+}
+
+// GenreNodesToDTOList maps genre tree nodes to DTOs, keeping their order.
+func (m *ApplicationMapper) GenreNodesToDTOList(nodes []entity.GenreNode) []dto.GenreNodeDTO {
+	dtos := make([]dto.GenreNodeDTO, 0, len(nodes))
+	for _, node := range nodes {
+		dtos = append(dtos, dto.GenreNodeDTO{
+			ID:       node.ID,
+			Name:     node.Name,
+			ParentID: node.ParentID,
+		})
+	}
+
+	return dtos
+}
+
+// RankPointToDTO maps a rank point of a tracked chart to a DTO.
+func (m *ApplicationMapper) RankPointToDTO(point *entity.RankPoint) dto.RankPointDTO {
+	return dto.RankPointDTO{
+		CapturedAt: point.CapturedAt,
+		Currency:   point.Currency,
+		Position:   point.Position,
+		Price:      point.Price,
+	}
+}
+
+// RankPointsToDTOList maps rank points of a tracked chart to DTOs.
+func (m *ApplicationMapper) RankPointsToDTOList(points []entity.RankPoint) []dto.RankPointDTO {
+	dtos := make([]dto.RankPointDTO, 0, len(points))
+	for i := range points {
+		dtos = append(dtos, m.RankPointToDTO(&points[i]))
+	}
+
+	return dtos
+}
+
 // DownloadInfoToDTO maps a DownloadInfo entity to DownloadInfoDTO.
 func (m *ApplicationMapper) DownloadInfoToDTO(info *entity.DownloadInfo) dto.DownloadInfoDTO {
 	return dto.DownloadInfoDTO{
@@ -83,3 +214,84 @@ func (m *ApplicationMapper) DownloadInfoToDTO(info *entity.DownloadInfo) dto.Dow
 		FileSize:    info.FileSize(),
 	}
 }
+
+// genresToDTO maps genres to DTOs.
+func (m *ApplicationMapper) genresToDTO(genres []entity.Genre) []dto.GenreDTO {
+	dtos := make([]dto.GenreDTO, 0, len(genres))
+	for _, genre := range genres {
+		dtos = append(dtos, dto.GenreDTO{ID: genre.ID, Name: genre.Name, URL: genre.URL})
+	}
+
+	return dtos
+}
+
+// offersToDTO maps offers to DTOs.
+func (m *ApplicationMapper) offersToDTO(offers []entity.Offer) []dto.OfferDTO {
+	dtos := make([]dto.OfferDTO, 0, len(offers))
+
+	for _, offer := range offers {
+		assets := make([]dto.OfferAssetDTO, 0, len(offer.Assets))
+		for _, asset := range offer.Assets {
+			assets = append(assets, dto.OfferAssetDTO{Flavor: asset.Flavor, Size: asset.Size})
+		}
+
+		dtos = append(dtos, dto.OfferDTO{
+			Type:           offer.Type,
+			Price:          offer.Price,
+			PriceFormatted: offer.PriceFormatted,
+			Currency:       offer.Currency,
+			BuyParams:      offer.BuyParams,
+			Version:        offer.Version,
+			VersionID:      offer.VersionID,
+			Assets:         assets,
+		})
+	}
+
+	return dtos
+}
+
+// artworkToDTO maps an artwork template to a DTO.
+func (m *ApplicationMapper) artworkToDTO(artwork entity.Artwork) dto.ArtworkDTO {
+	return dto.ArtworkDTO{
+		URLTemplate: artwork.URLTemplate,
+		Width:       artwork.Width,
+		Height:      artwork.Height,
+	}
+}
+
+// contentRatingToDTO maps a ContentRating to ContentRatingDTO, keeping nil for unrated applications.
+func (m *ApplicationMapper) contentRatingToDTO(rating *valueobject.ContentRating) *dto.ContentRatingDTO {
+	if rating == nil {
+		return nil
+	}
+
+	return &dto.ContentRatingDTO{
+		System:     rating.System(),
+		Label:      rating.Label(),
+		Advisories: rating.Advisories(),
+		Rank:       rating.Rank(),
+		MinimumAge: rating.MinimumAge(),
+	}
+}
+
+// privacyCategoriesToDTO maps App Privacy data categories to their names.
+func (m *ApplicationMapper) privacyCategoriesToDTO(categories []entity.PrivacyCategory) []string {
+	dtos := make([]string, 0, len(categories))
+	for _, category := range categories {
+		dtos = append(dtos, string(category))
+	}
+
+	return dtos
+}
+
+// platformNames returns the names of the platforms of a device family set.
+func (m *ApplicationMapper) platformNames(families valueobject.DeviceFamilies) []string {
+	platforms := families.Platforms()
+
+	names := make([]string, 0, len(platforms))
+	for _, platform := range platforms {
+		names = append(names, platform.Name())
+	}
+
+	return names
+}
@@ -39,6 +39,7 @@ func (uc *Authenticate) Execute(ctx context.Context, req dto.AuthenticateRequest
 		AppleID:       credentials.AppleID(),
 		PasswordToken: credentials.PasswordToken(),
 		DSID:          credentials.DSID(),
+		Pod:           credentials.Pod(),
 		Authenticated: credentials.IsAuthenticated(),
 	}, nil
 }
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/application/mapper"
+	"github.com/truewebber/goitunes/v2/internal/domain/entity"
+	"github.com/truewebber/goitunes/v2/internal/domain/repository"
+)
+
+// RecordChart fetches a chart and stores it in a chart store for rank tracking.
+type RecordChart struct {
+	getTopCharts *GetTopCharts
+	store        repository.ChartStore
+	mapper       *mapper.ApplicationMapper
+	storefront   string
+	device       string
+}
+
+// NewRecordChart creates a new RecordChart use case for the charts of a storefront
+// and device platform, e.g. "us" and "iphone".
+func NewRecordChart(
+	getTopCharts *GetTopCharts,
+	store repository.ChartStore,
+	storefront, device string,
+) *RecordChart {
+	return &RecordChart{
+		getTopCharts: getTopCharts,
+		store:        store,
+		mapper:       mapper.NewApplicationMapper(),
+		storefront:   storefront,
+		device:       device,
+	}
+}
+
+// Execute fetches the chart, stores its items and returns them as a snapshot.
+func (uc *RecordChart) Execute(ctx context.Context, req *dto.GetTopChartsRequest) (*dto.ChartSnapshotDTO, error) {
+	snapshot, err := takeSnapshot(ctx, uc.getTopCharts, uc.storefront, uc.device, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record chart: %w", err)
+	}
+
+	if err = uc.store.SaveSnapshot(ctx, snapshot.Key, snapshot.CapturedAt, snapshot.Chart.Items()); err != nil {
+		return nil, fmt.Errorf("failed to save chart snapshot: %w", err)
+	}
+
+	snapshotDTO := uc.mapper.ChartSnapshotToDTO(snapshot)
+
+	return &snapshotDTO, nil
+}
+
+// QueryChartHistory answers rank tracking queries from a chart store.
+type QueryChartHistory struct {
+	store      repository.ChartStore
+	mapper     *mapper.ApplicationMapper
+	storefront string
+	device     string
+}
+
+// NewQueryChartHistory creates a new QueryChartHistory use case for the charts
+// of a storefront and device platform.
+func NewQueryChartHistory(store repository.ChartStore, storefront, device string) *QueryChartHistory {
+	return &QueryChartHistory{
+		store:      store,
+		mapper:     mapper.NewApplicationMapper(),
+		storefront: storefront,
+		device:     device,
+	}
+}
+
+// RankHistory returns the positions of an application in the chart of req captured
+// within [from, to], oldest first. Zero times leave the range open.
+func (uc *QueryChartHistory) RankHistory(
+	ctx context.Context,
+	req *dto.GetTopChartsRequest,
+	adamID string,
+	from, to time.Time,
+) ([]dto.RankPointDTO, error) {
+	points, err := uc.store.RankHistory(ctx, chartKey(uc.storefront, uc.device, req), adamID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rank history: %w", err)
+	}
+
+	return uc.mapper.RankPointsToDTOList(points), nil
+}
+
+// BestRank returns the highest position an application reached in the chart of req.
+func (uc *QueryChartHistory) BestRank(
+	ctx context.Context,
+	req *dto.GetTopChartsRequest,
+	adamID string,
+) (*dto.RankPointDTO, error) {
+	point, err := uc.store.BestRank(ctx, chartKey(uc.storefront, uc.device, req), adamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get best rank: %w", err)
+	}
+
+	best := uc.mapper.RankPointToDTO(point)
+
+	return &best, nil
+}
+
+// DaysInTop returns the number of days an application spent at position topN or
+// better in the chart of req.
+func (uc *QueryChartHistory) DaysInTop(
+	ctx context.Context,
+	req *dto.GetTopChartsRequest,
+	adamID string,
+	topN int,
+) (int, error) {
+	days, err := uc.store.DaysInTop(ctx, chartKey(uc.storefront, uc.device, req), adamID, topN)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get days in top %d: %w", topN, err)
+	}
+
+	return days, nil
+}
+
+// chartKey builds the key of the chart of req. Chart types are normalised like
+// GetTopCharts does, so "" and "topfree" address the same history. The age band
+// and age filter change the chart, so they are part of the key; the range is not.
+func chartKey(storefront, device string, req *dto.GetTopChartsRequest) entity.ChartKey {
+	return entity.ChartKey{
+		Storefront: storefront,
+		GenreID:    req.GenreID,
+		ChartType:  parseChartType(req.ChartType),
+		Device:     device,
+		AgeBand:    req.KidPrefix,
+		MaxAge:     req.MaxAge,
+	}
+}
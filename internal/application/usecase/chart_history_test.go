@@ -0,0 +1,187 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/application/usecase"
+	"github.com/truewebber/goitunes/v2/internal/domain/entity"
+	"github.com/truewebber/goitunes/v2/internal/domain/repository"
+	"github.com/truewebber/goitunes/v2/internal/domain/repository/mocks"
+)
+
+func TestRecordChart_Execute(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	items := []*entity.ChartItem{
+		entity.NewChartItem(entity.NewApplication("1", "com.test.one", "One"), 1, entity.ChartTypeTopPaid),
+		entity.NewChartItem(entity.NewApplication("2", "com.test.two", "Two"), 2, entity.ChartTypeTopPaid),
+	}
+
+	mockRepo := mocks.NewMockChartRepository(ctrl)
+	mockRepo.EXPECT().
+		GetTop200(gomock.Any(), "6014", entity.ChartTypeTopPaid, "", 1, 200).
+		Return(entity.NewChart(items), nil)
+
+	key := entity.ChartKey{Storefront: "us", GenreID: "6014", ChartType: entity.ChartTypeTopPaid, Device: "mac"}
+
+	mockStore := mocks.NewMockChartStore(ctrl)
+	mockStore.EXPECT().
+		SaveSnapshot(gomock.Any(), key, gomock.Any(), items).
+		Return(nil)
+
+	uc := usecase.NewRecordChart(usecase.NewGetTopCharts(mockRepo), mockStore, "us", "mac")
+
+	snapshot, err := uc.Execute(context.Background(), &dto.GetTopChartsRequest{GenreID: "6014", ChartType: "toppaid"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if snapshot.Storefront != "us" || snapshot.Device != "mac" || snapshot.ChartType != "toppaid" {
+		t.Errorf("Unexpected snapshot chart %s/%s/%s", snapshot.Storefront, snapshot.Device, snapshot.ChartType)
+	}
+
+	if len(snapshot.Items) != 2 || snapshot.Timestamp.IsZero() {
+		t.Errorf("Expected 2 items with a timestamp, got %+v", snapshot)
+	}
+}
+
+func TestRecordChart_Execute_Filtered(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockChartRepository(ctrl)
+	mockRepo.EXPECT().
+		GetTop200(gomock.Any(), "36", entity.ChartTypeTopFree, "KIDS_6_8", 1, 200).
+		Return(entity.NewChart(nil), nil)
+
+	key := entity.ChartKey{
+		Storefront: "us", GenreID: "36", ChartType: entity.ChartTypeTopFree, Device: "iphone",
+		AgeBand: "KIDS_6_8", MaxAge: 9,
+	}
+
+	mockStore := mocks.NewMockChartStore(ctrl)
+	mockStore.EXPECT().
+		SaveSnapshot(gomock.Any(), key, gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	uc := usecase.NewRecordChart(usecase.NewGetTopCharts(mockRepo), mockStore, "us", "iphone")
+
+	snapshot, err := uc.Execute(context.Background(), &dto.GetTopChartsRequest{
+		GenreID: "36", KidPrefix: "KIDS_6_8", MaxAge: 9, From: 1, Limit: 200,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if snapshot.AgeBand != "KIDS_6_8" || snapshot.MaxAge != 9 {
+		t.Errorf("Expected the age band and max age in the snapshot, got %q and %d", snapshot.AgeBand, snapshot.MaxAge)
+	}
+}
+
+func TestRecordChart_Execute_StoreError(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	errDiskFull := errors.New("disk full")
+
+	mockRepo := mocks.NewMockChartRepository(ctrl)
+	mockRepo.EXPECT().
+		GetTop200(gomock.Any(), "36", entity.ChartTypeTopFree, "", 1, 200).
+		Return(entity.NewChart(nil), nil)
+
+	mockStore := mocks.NewMockChartStore(ctrl)
+	mockStore.EXPECT().
+		SaveSnapshot(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errDiskFull)
+
+	uc := usecase.NewRecordChart(usecase.NewGetTopCharts(mockRepo), mockStore, "us", "iphone")
+
+	_, err := uc.Execute(context.Background(), &dto.GetTopChartsRequest{GenreID: "36"})
+	if !errors.Is(err, errDiskFull) {
+		t.Errorf("Expected store error, got %v", err)
+	}
+}
+
+func TestQueryChartHistory(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	key := entity.ChartKey{Storefront: "gb", GenreID: "36", ChartType: entity.ChartTypeTopFree, Device: "iphone"}
+	day := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	mockStore := mocks.NewMockChartStore(ctrl)
+	mockStore.EXPECT().
+		RankHistory(gomock.Any(), key, "1", day, time.Time{}).
+		Return([]entity.RankPoint{
+			{CapturedAt: day, Position: 4, Price: 0, Currency: "GBP"},
+			{CapturedAt: day.Add(24 * time.Hour), Position: 2, Price: 0, Currency: "GBP"},
+		}, nil)
+	mockStore.EXPECT().
+		BestRank(gomock.Any(), key, "1").
+		Return(&entity.RankPoint{CapturedAt: day.Add(24 * time.Hour), Position: 2, Currency: "GBP"}, nil)
+	mockStore.EXPECT().
+		BestRank(gomock.Any(), key, "2").
+		Return(nil, repository.ErrNoRankHistory)
+	mockStore.EXPECT().
+		DaysInTop(gomock.Any(), key, "1", 10).
+		Return(2, nil)
+
+	kidsKey := key
+	kidsKey.AgeBand = "KIDS_6_8"
+	kidsKey.MaxAge = 9
+
+	mockStore.EXPECT().
+		DaysInTop(gomock.Any(), kidsKey, "1", 10).
+		Return(1, nil)
+
+	uc := usecase.NewQueryChartHistory(mockStore, "gb", "iphone")
+	ctx := context.Background()
+	topFree := &dto.GetTopChartsRequest{GenreID: "36", ChartType: "topfree"}
+
+	// An empty chart type addresses the top free chart, as it does for GetTopCharts.
+	history, err := uc.RankHistory(ctx, &dto.GetTopChartsRequest{GenreID: "36"}, "1", day, time.Time{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(history) != 2 || history[1].Position != 2 || history[1].Currency != "GBP" {
+		t.Errorf("Unexpected rank history %+v", history)
+	}
+
+	best, err := uc.BestRank(ctx, topFree, "1")
+	if err != nil || best.Position != 2 {
+		t.Errorf("Expected best rank 2, got %+v, %v", best, err)
+	}
+
+	if _, err = uc.BestRank(ctx, topFree, "2"); !errors.Is(err, repository.ErrNoRankHistory) {
+		t.Errorf("Expected ErrNoRankHistory, got %v", err)
+	}
+
+	days, err := uc.DaysInTop(ctx, topFree, "1", 10)
+	if err != nil || days != 2 {
+		t.Errorf("Expected 2 days in top 10, got %d, %v", days, err)
+	}
+
+	// Filtered charts are tracked apart from the full chart.
+	kids := &dto.GetTopChartsRequest{GenreID: "36", ChartType: "topfree", KidPrefix: "KIDS_6_8", MaxAge: 9}
+
+	days, err = uc.DaysInTop(ctx, kids, "1", 10)
+	if err != nil || days != 1 {
+		t.Errorf("Expected 1 day in the kids top 10, got %d, %v", days, err)
+	}
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/application/mapper"
+	"github.com/truewebber/goitunes/v2/internal/domain/entity"
+)
+
+// TakeChartSnapshot captures a chart together with its storefront and capture time.
+type TakeChartSnapshot struct {
+	getTopCharts *GetTopCharts
+	mapper       *mapper.ApplicationMapper
+	storefront   string
+	device       string
+}
+
+// NewTakeChartSnapshot creates a new TakeChartSnapshot use case for the charts
+// of a storefront and device platform, e.g. "us" and "iphone".
+func NewTakeChartSnapshot(getTopCharts *GetTopCharts, storefront, device string) *TakeChartSnapshot {
+	return &TakeChartSnapshot{
+		getTopCharts: getTopCharts,
+		mapper:       mapper.NewApplicationMapper(),
+		storefront:   storefront,
+		device:       device,
+	}
+}
+
+// Execute fetches the chart and wraps it into a snapshot.
+func (uc *TakeChartSnapshot) Execute(
+	ctx context.Context,
+	req *dto.GetTopChartsRequest,
+) (*dto.ChartSnapshotDTO, error) {
+	snapshot, err := takeSnapshot(ctx, uc.getTopCharts, uc.storefront, uc.device, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take chart snapshot: %w", err)
+	}
+
+	snapshotDTO := uc.mapper.ChartSnapshotToDTO(snapshot)
+
+	return &snapshotDTO, nil
+}
+
+// takeSnapshot fetches the chart of req and captures it with the key of the chart
+// in the storefront and device.
+func takeSnapshot(
+	ctx context.Context,
+	getTopCharts *GetTopCharts,
+	storefront, device string,
+	req *dto.GetTopChartsRequest,
+) (*entity.ChartSnapshot, error) {
+	chart, _, err := getTopCharts.chart(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.ChartSnapshot{
+		Key:        chartKey(storefront, device, req),
+		CapturedAt: time.Now().UTC(),
+		Chart:      chart,
+	}, nil
+}
+
+// DiffChartSnapshots compares two snapshots of the same chart. It compares snapshot
+// DTOs, since snapshots are usually persisted as JSON and compared after reloading.
+type DiffChartSnapshots struct{}
+
+// NewDiffChartSnapshots creates a new DiffChartSnapshots use case.
+func NewDiffChartSnapshots() *DiffChartSnapshots {
+	return &DiffChartSnapshots{}
+}
+
+// Execute reports entries that are new, dropped out, or moved between previous and current.
+func (uc *DiffChartSnapshots) Execute(previous, current *dto.ChartSnapshotDTO) (*dto.ChartDiffDTO, error) {
+	if previous == nil || current == nil {
+		return nil, ErrMissingSnapshot
+	}
+
+	if previous.Storefront != current.Storefront ||
+		previous.GenreID != current.GenreID ||
+		string(parseChartType(previous.ChartType)) != string(parseChartType(current.ChartType)) ||
+		!sameDevice(previous.Device, current.Device) ||
+		previous.AgeBand != current.AgeBand ||
+		previous.MaxAge != current.MaxAge {
+		return nil, fmt.Errorf("%w: %s vs %s", ErrIncomparableSnapshots, uc.chartName(previous), uc.chartName(current))
+	}
+
+	diff := &dto.ChartDiffDTO{
+		From:      previous.Timestamp,
+		To:        current.Timestamp,
+		New:       make([]dto.ChartItemDTO, 0),
+		Dropped:   make([]dto.ChartItemDTO, 0),
+		MovedUp:   make([]dto.ChartMovementDTO, 0),
+		MovedDown: make([]dto.ChartMovementDTO, 0),
+	}
+
+	// Unresolved positions take part in the comparison, so an application whose
+	// info could not be looked up in one run is not reported as new or dropped.
+	previousPositions := uc.positionsByAdamID(previous)
+	currentPositions := uc.positionsByAdamID(current)
+
+	for _, item := range current.Items {
+		previousPosition, existed := previousPositions[item.App.AdamID]
+
+		switch {
+		case !existed:
+			diff.New = append(diff.New, item)
+		case item.Position < previousPosition:
+			diff.MovedUp = append(diff.MovedUp, uc.movement(item, previousPosition))
+		case item.Position > previousPosition:
+			diff.MovedDown = append(diff.MovedDown, uc.movement(item, previousPosition))
+		default:
+			diff.Unchanged++
+		}
+	}
+
+	for _, item := range previous.Items {
+		if _, exists := currentPositions[item.App.AdamID]; !exists {
+			diff.Dropped = append(diff.Dropped, item)
+		}
+	}
+
+	return diff, nil
+}
+
+// positionsByAdamID indexes the resolved and unresolved chart positions of a snapshot by Adam ID.
+func (uc *DiffChartSnapshots) positionsByAdamID(snapshot *dto.ChartSnapshotDTO) map[string]int {
+	positions := make(map[string]int, len(snapshot.Items)+len(snapshot.Unresolved))
+	for _, item := range snapshot.Items {
+		positions[item.App.AdamID] = item.Position
+	}
+
+	for _, item := range snapshot.Unresolved {
+		positions[item.AdamID] = item.Position
+	}
+
+	return positions
+}
+
+// chartName describes the chart of a snapshot for error messages.
+func (uc *DiffChartSnapshots) chartName(snapshot *dto.ChartSnapshotDTO) string {
+	name := fmt.Sprintf("%s/%s/%s/%s", snapshot.Storefront, snapshot.GenreID, snapshot.ChartType, snapshot.Device)
+
+	if snapshot.AgeBand != "" {
+		name += "/ageBand=" + snapshot.AgeBand
+	}
+
+	if snapshot.MaxAge != 0 {
+		name += fmt.Sprintf("/maxAge=%d", snapshot.MaxAge)
+	}
+
+	return name
+}
+
+// movement builds a movement record for an item that changed position.
+func (uc *DiffChartSnapshots) movement(item dto.ChartItemDTO, previousPosition int) dto.ChartMovementDTO {
+	return dto.ChartMovementDTO{
+		App:              item.App,
+		PreviousPosition: previousPosition,
+		Position:         item.Position,
+		Delta:            previousPosition - item.Position,
+	}
+}
+
+// sameDevice reports whether two snapshot devices match. Snapshots taken before
+// the device was recorded have none and match any device.
+func sameDevice(previous, current string) bool {
+	return previous == "" || current == "" || previous == current
+}
@@ -0,0 +1,187 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/application/usecase"
+	"github.com/truewebber/goitunes/v2/internal/domain/entity"
+	"github.com/truewebber/goitunes/v2/internal/domain/repository/mocks"
+)
+
+func newSnapshot(timestamp time.Time, adamIDs ...string) *dto.ChartSnapshotDTO {
+	items := make([]dto.ChartItemDTO, 0, len(adamIDs))
+	for i, adamID := range adamIDs {
+		items = append(items, dto.ChartItemDTO{
+			App:      dto.ApplicationDTO{AdamID: adamID},
+			Position: i + 1,
+		})
+	}
+
+	return &dto.ChartSnapshotDTO{
+		Timestamp:  timestamp,
+		Storefront: "us",
+		GenreID:    "36",
+		ChartType:  "topfree",
+		Items:      items,
+	}
+}
+
+func TestDiffChartSnapshots_Execute(t *testing.T) {
+	t.Parallel()
+
+	yesterday := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	today := yesterday.Add(24 * time.Hour)
+
+	previous := newSnapshot(yesterday, "a", "b", "c", "d")
+	current := newSnapshot(today, "c", "b", "a", "e")
+
+	diff, err := usecase.NewDiffChartSnapshots().Execute(previous, current)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !diff.From.Equal(yesterday) || !diff.To.Equal(today) {
+		t.Errorf("Unexpected diff period: %v - %v", diff.From, diff.To)
+	}
+
+	if len(diff.New) != 1 || diff.New[0].App.AdamID != "e" {
+		t.Errorf("Expected new entry e, got %+v", diff.New)
+	}
+
+	if len(diff.Dropped) != 1 || diff.Dropped[0].App.AdamID != "d" {
+		t.Errorf("Expected dropped entry d, got %+v", diff.Dropped)
+	}
+
+	if len(diff.MovedUp) != 1 {
+		t.Fatalf("Expected one upward movement, got %+v", diff.MovedUp)
+	}
+
+	up := diff.MovedUp[0]
+	if up.App.AdamID != "c" || up.PreviousPosition != 3 || up.Position != 1 || up.Delta != 2 {
+		t.Errorf("Unexpected upward movement: %+v", up)
+	}
+
+	if len(diff.MovedDown) != 1 {
+		t.Fatalf("Expected one downward movement, got %+v", diff.MovedDown)
+	}
+
+	down := diff.MovedDown[0]
+	if down.App.AdamID != "a" || down.PreviousPosition != 1 || down.Position != 3 || down.Delta != -2 {
+		t.Errorf("Unexpected downward movement: %+v", down)
+	}
+
+	if diff.Unchanged != 1 {
+		t.Errorf("Expected one unchanged entry, got %d", diff.Unchanged)
+	}
+}
+
+func TestDiffChartSnapshots_Execute_Errors(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	otherGenre := newSnapshot(now, "a")
+	otherGenre.GenreID = "6014"
+	ageBand := newSnapshot(now, "a")
+	ageBand.AgeBand = "10001"
+	maxAge := newSnapshot(now, "a")
+	maxAge.MaxAge = 9
+
+	tests := []struct {
+		name          string
+		previous      *dto.ChartSnapshotDTO
+		current       *dto.ChartSnapshotDTO
+		expectedError error
+	}{
+		{"nil previous", nil, newSnapshot(now, "a"), usecase.ErrMissingSnapshot},
+		{"nil current", newSnapshot(now, "a"), nil, usecase.ErrMissingSnapshot},
+		{"different genre", newSnapshot(now, "a"), otherGenre, usecase.ErrIncomparableSnapshots},
+		{"different age band", newSnapshot(now, "a"), ageBand, usecase.ErrIncomparableSnapshots},
+		{"different max age", maxAge, newSnapshot(now, "a"), usecase.ErrIncomparableSnapshots},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			diff, err := usecase.NewDiffChartSnapshots().Execute(tt.previous, tt.current)
+			if !errors.Is(err, tt.expectedError) {
+				t.Errorf("Expected %v, got %v", tt.expectedError, err)
+			}
+
+			if diff != nil {
+				t.Error("Diff should be nil for error case")
+			}
+		})
+	}
+}
+
+func TestDiffChartSnapshots_Execute_Unresolved(t *testing.T) {
+	t.Parallel()
+
+	yesterday := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// b could not be looked up yesterday, c cannot be looked up today.
+	previous := newSnapshot(yesterday, "a", "c")
+	previous.Unresolved = []dto.UnresolvedChartItemDTO{{AdamID: "b", Position: 3}}
+
+	current := newSnapshot(yesterday.Add(24*time.Hour), "b", "a")
+	current.Unresolved = []dto.UnresolvedChartItemDTO{{AdamID: "c", Position: 3}}
+
+	// A snapshot without chart type is the top free chart.
+	current.ChartType = ""
+
+	diff, err := usecase.NewDiffChartSnapshots().Execute(previous, current)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(diff.New) != 0 || len(diff.Dropped) != 0 {
+		t.Errorf("Expected unresolved entries not to be new or dropped, got %+v and %+v", diff.New, diff.Dropped)
+	}
+
+	if len(diff.MovedUp) != 1 || diff.MovedUp[0].App.AdamID != "b" || diff.MovedUp[0].PreviousPosition != 3 {
+		t.Errorf("Expected b to move up from 3, got %+v", diff.MovedUp)
+	}
+
+	if len(diff.MovedDown) != 1 || diff.MovedDown[0].App.AdamID != "a" {
+		t.Errorf("Expected a to move down, got %+v", diff.MovedDown)
+	}
+}
+
+func TestTakeChartSnapshot_Execute(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chart := entity.NewChart([]*entity.ChartItem{
+		entity.NewChartItem(entity.NewApplication("1", "com.test.one", "One"), 1, entity.ChartTypeTopFree),
+	})
+	chart.AddError(&entity.ChartItemError{AdamID: "2", Position: 2, Err: errLookupFailed})
+
+	mockRepo := mocks.NewMockChartRepository(ctrl)
+	mockRepo.EXPECT().
+		GetTop200(gomock.Any(), "36", entity.ChartTypeTopFree, "10001", 1, 200).
+		Return(chart, nil)
+
+	uc := usecase.NewTakeChartSnapshot(usecase.NewGetTopCharts(mockRepo), "us", "iphone")
+
+	snapshot, err := uc.Execute(context.Background(), &dto.GetTopChartsRequest{GenreID: "36", KidPrefix: "10001"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if snapshot.ChartType != "topfree" || snapshot.AgeBand != "10001" || snapshot.Device != "iphone" {
+		t.Errorf("Unexpected snapshot chart %s/%s/%s", snapshot.ChartType, snapshot.AgeBand, snapshot.Device)
+	}
+
+	if len(snapshot.Items) != 1 || len(snapshot.Unresolved) != 1 || snapshot.Unresolved[0].AdamID != "2" {
+		t.Errorf("Expected one resolved and one unresolved position, got %+v and %+v", snapshot.Items, snapshot.Unresolved)
+	}
+}
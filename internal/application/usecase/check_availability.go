@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+)
+
+// CheckAvailability builds an availability matrix from lookups of one application in several regions.
+type CheckAvailability struct{}
+
+// NewCheckAvailability creates a new CheckAvailability use case.
+func NewCheckAvailability() *CheckAvailability {
+	return &CheckAvailability{}
+}
+
+// Execute reports the availability, price and version of the application id in every
+// region of resultsByRegion and errsByRegion. Regions selling a version other than
+// the one sold in most regions are flagged; on a tie the newer version wins.
+func (uc *CheckAvailability) Execute(
+	id string,
+	resultsByRegion map[string]dto.LookupResultDTO,
+	errsByRegion map[string]error,
+) *dto.AvailabilityDTO {
+	regions := slices.Sorted(maps.Keys(resultsByRegion))
+	for region := range errsByRegion {
+		if _, ok := resultsByRegion[region]; !ok {
+			regions = append(regions, region)
+		}
+	}
+
+	slices.Sort(regions)
+
+	availability := &dto.AvailabilityDTO{
+		ID:      id,
+		Regions: make([]dto.RegionAvailabilityDTO, 0, len(regions)),
+	}
+
+	versionCounts := make(map[string]int)
+
+	for _, region := range regions {
+		regional := dto.RegionAvailabilityDTO{Region: region, Status: dto.LookupStatusFailed}
+
+		if result, ok := resultsByRegion[region]; ok {
+			regional.Status = result.Status
+			regional.Error = result.Error
+			regional.Available = result.Status == dto.LookupStatusFound
+
+			if result.App != nil {
+				regional.Price = result.App.Price
+				regional.Currency = result.App.Currency
+				regional.Version = result.App.Version
+			}
+		} else {
+			regional.Error = errsByRegion[region].Error()
+		}
+
+		if regional.Available && regional.Version != "" {
+			versionCounts[regional.Version]++
+		}
+
+		availability.Regions = append(availability.Regions, regional)
+	}
+
+	availability.MajorityVersion = uc.majorityVersion(versionCounts)
+
+	for i := range availability.Regions {
+		regional := &availability.Regions[i]
+		regional.VersionMismatch = regional.Available && regional.Version != availability.MajorityVersion
+	}
+
+	return availability
+}
+
+// majorityVersion returns the most common version, preferring the newer version on a tie.
+func (uc *CheckAvailability) majorityVersion(counts map[string]int) string {
+	var (
+		majority string
+		best     int
+	)
+
+	for _, version := range slices.SortedFunc(maps.Keys(counts), compareVersions) {
+		if counts[version] >= best {
+			majority, best = version, counts[version]
+		}
+	}
+
+	return majority
+}
+
+// compareVersions orders dotted version strings segment by segment, numeric
+// segments by value, so "1.9" sorts before "1.10". Missing segments count as
+// zero; versions that still compare equal, e.g. "1.0" and "1", are ordered as strings.
+func compareVersions(a, b string) int {
+	segmentsA := strings.Split(a, ".")
+	segmentsB := strings.Split(b, ".")
+
+	for i := range max(len(segmentsA), len(segmentsB)) {
+		x, y := "0", "0"
+
+		if i < len(segmentsA) {
+			x = segmentsA[i]
+		}
+
+		if i < len(segmentsB) {
+			y = segmentsB[i]
+		}
+
+		numX, errX := strconv.Atoi(x)
+		numY, errY := strconv.Atoi(y)
+
+		result := cmp.Compare(x, y)
+		if errX == nil && errY == nil {
+			result = cmp.Compare(numX, numY)
+		}
+
+		if result != 0 {
+			return result
+		}
+	}
+
+	return cmp.Compare(a, b)
+}
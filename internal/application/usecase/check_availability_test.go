@@ -0,0 +1,53 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/application/usecase"
+)
+
+func found(version string) dto.LookupResultDTO {
+	return dto.LookupResultDTO{
+		ID:     "com.test.app",
+		Status: dto.LookupStatusFound,
+		App:    &dto.ApplicationDTO{Version: version},
+	}
+}
+
+func TestCheckAvailability_Execute_MajorityVersionTie(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		versions map[string]string
+		majority string
+	}{
+		{"numeric minor", map[string]string{"us": "1.10", "de": "1.9"}, "1.10"},
+		{"numeric major", map[string]string{"us": "9.0", "de": "10.0", "jp": "10.0", "fr": "9.0"}, "10.0"},
+		{"extra segment", map[string]string{"us": "2.1", "de": "2.1.1"}, "2.1.1"},
+		{"most common wins", map[string]string{"us": "1.9", "de": "1.9", "jp": "1.10"}, "1.9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			results := make(map[string]dto.LookupResultDTO, len(tt.versions))
+			for region, version := range tt.versions {
+				results[region] = found(version)
+			}
+
+			availability := usecase.NewCheckAvailability().Execute("com.test.app", results, nil)
+			if availability.MajorityVersion != tt.majority {
+				t.Errorf("Expected majority version %s, got %s", tt.majority, availability.MajorityVersion)
+			}
+
+			for _, regional := range availability.Regions {
+				if regional.VersionMismatch != (regional.Version != tt.majority) {
+					t.Errorf("Unexpected version mismatch flag for %s on %s", regional.Region, regional.Version)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/domain/service"
+)
+
+// ComparePrices converts application prices from several regions into one base currency.
+type ComparePrices struct {
+	rates           service.ExchangeRateProvider
+	currencyService *service.CurrencyService
+}
+
+// NewComparePrices creates a new ComparePrices use case.
+func NewComparePrices(rates service.ExchangeRateProvider) *ComparePrices {
+	return &ComparePrices{
+		rates:           rates,
+		currencyService: service.NewCurrencyService(),
+	}
+}
+
+// Execute groups the applications of every region by Adam ID and converts their prices
+// to baseCurrency. A price that cannot be converted is kept with an error instead of
+// failing the whole comparison; only invalid input and context errors are returned.
+func (uc *ComparePrices) Execute(
+	ctx context.Context,
+	baseCurrency string,
+	appsByRegion map[string][]dto.ApplicationDTO,
+) (*dto.PriceComparisonDTO, error) {
+	if uc.rates == nil {
+		return nil, ErrMissingExchangeRates
+	}
+
+	base, err := uc.currencyService.NormalizeCurrencyCode(baseCurrency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base currency: %w", err)
+	}
+
+	regions := make([]string, 0, len(appsByRegion))
+	for region := range appsByRegion {
+		regions = append(regions, region)
+	}
+
+	slices.Sort(regions)
+
+	converter := &priceConverter{
+		uc:    uc,
+		base:  base,
+		rates: make(map[string]float64),
+	}
+	comparisons := make(map[string]*dto.AppPriceComparisonDTO)
+
+	for _, region := range regions {
+		for _, app := range appsByRegion[region] {
+			price, err := converter.convert(ctx, region, app)
+			if err != nil {
+				return nil, err
+			}
+
+			comparison, exists := comparisons[app.AdamID]
+			if !exists {
+				comparison = &dto.AppPriceComparisonDTO{
+					AdamID:   app.AdamID,
+					BundleID: app.BundleID,
+					Name:     app.Name,
+					Prices:   make([]dto.RegionPriceDTO, 0, len(regions)),
+				}
+				comparisons[app.AdamID] = comparison
+			}
+
+			comparison.Prices = append(comparison.Prices, price)
+		}
+	}
+
+	result := &dto.PriceComparisonDTO{
+		BaseCurrency: base,
+		Apps:         make([]dto.AppPriceComparisonDTO, 0, len(comparisons)),
+	}
+
+	for _, comparison := range comparisons {
+		uc.rank(comparison)
+		result.Apps = append(result.Apps, *comparison)
+	}
+
+	slices.SortFunc(result.Apps, func(a, b dto.AppPriceComparisonDTO) int {
+		return cmp.Compare(a.AdamID, b.AdamID)
+	})
+
+	return result, nil
+}
+
+// rank orders the prices of an application and marks the cheapest and most expensive regions.
+func (uc *ComparePrices) rank(comparison *dto.AppPriceComparisonDTO) {
+	slices.SortStableFunc(comparison.Prices, func(a, b dto.RegionPriceDTO) int {
+		if (a.Error == "") != (b.Error == "") {
+			if a.Error == "" {
+				return -1
+			}
+
+			return 1
+		}
+
+		return cmp.Or(cmp.Compare(a.BasePrice, b.BasePrice), cmp.Compare(a.Region, b.Region))
+	})
+
+	converted := slices.IndexFunc(comparison.Prices, func(p dto.RegionPriceDTO) bool {
+		return p.Error != ""
+	})
+	if converted < 0 {
+		converted = len(comparison.Prices)
+	}
+
+	if converted == 0 {
+		return
+	}
+
+	comparison.Cheapest = comparison.Prices[0].Region
+	comparison.MostExpensive = comparison.Prices[converted-1].Region
+}
+
+// priceConverter converts prices within a single comparison, caching rates per currency.
+type priceConverter struct {
+	uc    *ComparePrices
+	rates map[string]float64
+	base  string
+}
+
+// convert converts the price of app in region to the base currency.
+func (c *priceConverter) convert(ctx context.Context, region string, app dto.ApplicationDTO) (dto.RegionPriceDTO, error) {
+	price := dto.RegionPriceDTO{
+		Region:   region,
+		Currency: app.Currency,
+		Price:    app.Price,
+	}
+
+	if app.Price == 0 {
+		return price, nil
+	}
+
+	rate, err := c.rate(ctx, app.Currency)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return price, fmt.Errorf("failed to compare prices: %w", ctxErr)
+		}
+
+		price.Error = err.Error()
+
+		return price, nil
+	}
+
+	units := math.Pow10(c.uc.currencyService.MinorUnits(c.base))
+	price.BasePrice = math.Round(app.Price*rate*units) / units
+
+	return price, nil
+}
+
+// rate returns the conversion rate from currency to the base currency.
+func (c *priceConverter) rate(ctx context.Context, currency string) (float64, error) {
+	code, err := c.uc.currencyService.NormalizeCurrencyCode(currency)
+	if err != nil {
+		return 0, err
+	}
+
+	if code == c.base {
+		return 1, nil
+	}
+
+	if rate, ok := c.rates[code]; ok {
+		return rate, nil
+	}
+
+	rate, err := c.uc.rates.Rate(ctx, code, c.base)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get exchange rate: %w", err)
+	}
+
+	c.rates[code] = rate
+
+	return rate, nil
+}
@@ -0,0 +1,143 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/application/usecase"
+	"github.com/truewebber/goitunes/v2/internal/domain/service"
+)
+
+func TestComparePrices_Execute(t *testing.T) {
+	t.Parallel()
+
+	rates, err := service.NewStaticExchangeRates("USD", map[string]float64{
+		"EUR": 0.8,
+		"JPY": 150,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	app := func(price float64, currency string) dto.ApplicationDTO {
+		return dto.ApplicationDTO{AdamID: "1", BundleID: "com.test.app", Name: "Test", Price: price, Currency: currency}
+	}
+
+	appsByRegion := map[string][]dto.ApplicationDTO{
+		"us": {app(4.99, "USD")},
+		"de": {app(4.99, "EUR")},
+		"jp": {app(600, "JPY")},
+		"gb": {app(4.99, "GBP")},
+	}
+
+	comparison, err := usecase.NewComparePrices(rates).Execute(context.Background(), "usd", appsByRegion)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if comparison.BaseCurrency != "USD" {
+		t.Errorf("Expected base currency USD, got %q", comparison.BaseCurrency)
+	}
+
+	if len(comparison.Apps) != 1 {
+		t.Fatalf("Expected 1 application, got %d", len(comparison.Apps))
+	}
+
+	result := comparison.Apps[0]
+
+	expected := []struct {
+		region    string
+		basePrice float64
+	}{
+		{"jp", 4},
+		{"us", 4.99},
+		{"de", 6.24},
+	}
+
+	if len(result.Prices) != 4 {
+		t.Fatalf("Expected 4 prices, got %+v", result.Prices)
+	}
+
+	for i, want := range expected {
+		got := result.Prices[i]
+		if got.Region != want.region || got.BasePrice != want.basePrice || got.Error != "" {
+			t.Errorf("Position %d: expected %s at %v, got %+v", i, want.region, want.basePrice, got)
+		}
+	}
+
+	if unconverted := result.Prices[3]; unconverted.Region != "gb" || unconverted.Error == "" {
+		t.Errorf("Expected unconverted gb price last, got %+v", unconverted)
+	}
+
+	if result.Cheapest != "jp" || result.MostExpensive != "de" {
+		t.Errorf("Expected cheapest jp and most expensive de, got %s and %s", result.Cheapest, result.MostExpensive)
+	}
+}
+
+func TestComparePrices_Execute_FreeApp(t *testing.T) {
+	t.Parallel()
+
+	appsByRegion := map[string][]dto.ApplicationDTO{
+		"us": {{AdamID: "1", Currency: "USD"}},
+		"zz": {{AdamID: "1"}},
+	}
+
+	comparison, err := usecase.NewComparePrices(service.DefaultExchangeRates()).
+		Execute(context.Background(), "EUR", appsByRegion)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, price := range comparison.Apps[0].Prices {
+		if price.Error != "" || price.BasePrice != 0 {
+			t.Errorf("Expected free price without error, got %+v", price)
+		}
+	}
+}
+
+func TestComparePrices_Execute_Errors(t *testing.T) {
+	t.Parallel()
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name          string
+		rates         service.ExchangeRateProvider
+		ctx           context.Context
+		baseCurrency  string
+		expectedError error
+	}{
+		{"Missing provider", nil, context.Background(), "USD", usecase.ErrMissingExchangeRates},
+		{"Invalid base currency", service.DefaultExchangeRates(), context.Background(), "$", service.ErrInvalidCurrency},
+		{"Canceled context", canceledRates{}, canceled, "USD", context.Canceled},
+	}
+
+	appsByRegion := map[string][]dto.ApplicationDTO{
+		"de": {{AdamID: "1", Price: 1.99, Currency: "EUR"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			comparison, err := usecase.NewComparePrices(tt.rates).Execute(tt.ctx, tt.baseCurrency, appsByRegion)
+			if !errors.Is(err, tt.expectedError) {
+				t.Errorf("Expected %v, got %v", tt.expectedError, err)
+			}
+
+			if comparison != nil {
+				t.Error("Comparison should be nil for error case")
+			}
+		})
+	}
+}
+
+// canceledRates is a provider that fails with the context error.
+type canceledRates struct{}
+
+func (canceledRates) Rate(ctx context.Context, _, _ string) (float64, error) {
+	return 0, ctx.Err()
+}
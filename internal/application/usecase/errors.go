@@ -17,4 +17,13 @@ var (
 
 	// ErrMissingIdentifiers is returned when neither adamIDs nor bundleIDs are provided.
 	ErrMissingIdentifiers = errors.New("either adamIDs or bundleIDs must be provided")
+
+	// ErrMissingSnapshot is returned when a chart snapshot to compare is nil.
+	ErrMissingSnapshot = errors.New("both chart snapshots must be provided")
+
+	// ErrIncomparableSnapshots is returned when snapshots belong to different charts.
+	ErrIncomparableSnapshots = errors.New("snapshots belong to different charts")
+
+	// ErrMissingExchangeRates is returned when no exchange-rate provider is configured.
+	ErrMissingExchangeRates = errors.New("exchange-rate provider must be provided")
 )
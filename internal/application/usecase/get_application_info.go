@@ -1,19 +1,24 @@
 package usecase
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/truewebber/goitunes/v2/internal/application/dto"
 	"github.com/truewebber/goitunes/v2/internal/application/mapper"
 	"github.com/truewebber/goitunes/v2/internal/domain/entity"
 	"github.com/truewebber/goitunes/v2/internal/domain/repository"
+	"github.com/truewebber/goitunes/v2/internal/domain/service"
 )
 
 // GetApplicationInfo retrieves application information.
+// Long identifier lists are split into batches that are looked up concurrently.
 type GetApplicationInfo struct {
 	appRepo repository.ApplicationRepository
 	mapper  *mapper.ApplicationMapper
+	batches *service.BatchLookup
 }
 
 // NewGetApplicationInfo creates a new GetApplicationInfo use case.
@@ -21,34 +26,143 @@ func NewGetApplicationInfo(appRepo repository.ApplicationRepository) *GetApplica
 	return &GetApplicationInfo{
 		appRepo: appRepo,
 		mapper:  mapper.NewApplicationMapper(),
+		batches: service.NewBatchLookup(),
 	}
 }
 
-// Execute retrieves application information.
+// SetBatchLookup sets the batched lookup identifiers are fetched with. Nil keeps the current one.
+func (uc *GetApplicationInfo) SetBatchLookup(lookup *service.BatchLookup) *GetApplicationInfo {
+	if lookup != nil {
+		uc.batches = lookup
+	}
+
+	return uc
+}
+
+// Execute retrieves information about the applications found, in request order.
+// It fails if any batch could not be fetched; use Lookup to get partial results.
 func (uc *GetApplicationInfo) Execute(
 	ctx context.Context,
 	req dto.GetApplicationInfoRequest,
 ) (*dto.GetApplicationInfoResponse, error) {
-	var apps []*entity.Application
+	lookup, err := uc.lookup(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if lookup.firstErr != nil {
+		return nil, fmt.Errorf("failed to find apps: %w", lookup.firstErr)
+	}
+
+	apps := make([]dto.ApplicationDTO, 0, len(lookup.resp.Results))
 
-	var err error
+	for _, result := range lookup.resp.Results {
+		if result.App != nil {
+			apps = append(apps, *result.App)
+		}
+	}
+
+	return &dto.GetApplicationInfoResponse{
+		Applications: apps,
+	}, nil
+}
+
+// Lookup looks up every requested identifier and reports its status in request order.
+// A failed batch marks its identifiers as failed instead of failing the lookup;
+// only invalid requests and context errors are returned.
+func (uc *GetApplicationInfo) Lookup(
+	ctx context.Context,
+	req dto.GetApplicationInfoRequest,
+) (*dto.LookupApplicationsResponse, error) {
+	lookup, err := uc.lookup(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return lookup.resp, nil
+}
+
+// lookupOutcome holds a lookup response and the error of its first failed identifier.
+type lookupOutcome struct {
+	resp     *dto.LookupApplicationsResponse
+	firstErr error
+}
+
+// lookup implements Lookup and keeps the errors of failed identifiers for Execute.
+func (uc *GetApplicationInfo) lookup(
+	ctx context.Context,
+	req dto.GetApplicationInfoRequest,
+) (*lookupOutcome, error) {
+	var (
+		ids       []string
+		find      func(ctx context.Context, ids []string) ([]*entity.Application, error)
+		key       func(app *entity.Application) string
+		normalize func(id string) string
+	)
 
 	switch {
 	case len(req.AdamIDs) > 0:
-		apps, err = uc.appRepo.FindByAdamID(ctx, req.AdamIDs)
-		if err != nil {
-			return nil, fmt.Errorf("failed to find apps by adamID: %w", err)
-		}
+		ids, find = req.AdamIDs, uc.appRepo.FindByAdamID
+		normalize = strings.TrimSpace
+		key = (*entity.Application).AdamID
 	case len(req.BundleIDs) > 0:
-		apps, err = uc.appRepo.FindByBundleID(ctx, req.BundleIDs)
-		if err != nil {
-			return nil, fmt.Errorf("failed to find apps by bundleID: %w", err)
-		}
+		// Bundle IDs are case-insensitive in the App Store
+		ids, find = req.BundleIDs, uc.appRepo.FindByBundleID
+		normalize = func(id string) string { return strings.ToLower(strings.TrimSpace(id)) }
+		key = func(app *entity.Application) string { return normalize(app.BundleID()) }
 	default:
 		return nil, ErrMissingIdentifiers
 	}
 
-	return &dto.GetApplicationInfoResponse{
-		Applications: uc.mapper.ToDTOList(apps),
+	found, lookupErrors, err := uc.batches.Find(ctx, uniqueIDs(ids, normalize), find, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var firstErr error
+
+	results := make([]dto.LookupResultDTO, 0, len(ids))
+
+	for _, id := range ids {
+		result := dto.LookupResultDTO{ID: id, Status: dto.LookupStatusNotFound}
+
+		if app, ok := found[normalize(id)]; ok {
+			appDTO := uc.mapper.ToDTO(app)
+			result.App = &appDTO
+			result.Status = dto.LookupStatusFound
+
+			if !app.IsAvailable() {
+				result.Status = dto.LookupStatusNotAvailable
+			}
+		} else if lookupErr, failed := lookupErrors[normalize(id)]; failed {
+			result.Status = dto.LookupStatusFailed
+			result.Error = lookupErr.Error()
+			firstErr = cmp.Or(firstErr, lookupErr)
+		}
+
+		results = append(results, result)
+	}
+
+	return &lookupOutcome{
+		resp:     &dto.LookupApplicationsResponse{Results: results},
+		firstErr: firstErr,
 	}, nil
 }
+
+// uniqueIDs returns the non-empty normalized identifiers of ids without duplicates, in order.
+func uniqueIDs(ids []string, normalize func(string) string) []string {
+	seen := make(map[string]struct{}, len(ids))
+	unique := make([]string, 0, len(ids))
+
+	for _, id := range ids {
+		id = normalize(id)
+		if _, ok := seen[id]; ok || id == "" {
+			continue
+		}
+
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	return unique
+}
@@ -0,0 +1,222 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/application/usecase"
+	"github.com/truewebber/goitunes/v2/internal/domain/entity"
+	"github.com/truewebber/goitunes/v2/internal/domain/repository/mocks"
+	"github.com/truewebber/goitunes/v2/internal/domain/service"
+)
+
+func TestGetApplicationInfo_Lookup_Batches(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		mu      sync.Mutex
+		batches [][]string
+	)
+
+	mockRepo := mocks.NewMockApplicationRepository(ctrl)
+	mockRepo.EXPECT().
+		FindByAdamID(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, adamIDs []string) ([]*entity.Application, error) {
+			mu.Lock()
+			batches = append(batches, adamIDs)
+			mu.Unlock()
+
+			apps := make([]*entity.Application, 0, len(adamIDs))
+
+			// Results come back in reverse order, like a map iteration would
+			for i := len(adamIDs) - 1; i >= 0; i-- {
+				switch adamIDs[i] {
+				case "3":
+					continue
+				case "5":
+					apps = append(apps, entity.NewApplication("5", "com.test.5", "App 5").SetAvailable(false))
+				default:
+					apps = append(apps, entity.NewApplication(adamIDs[i], "com.test."+adamIDs[i], "App "+adamIDs[i]))
+				}
+			}
+
+			return apps, nil
+		}).
+		Times(3)
+
+	uc := usecase.NewGetApplicationInfo(mockRepo).
+		SetBatchLookup(service.NewBatchLookup().SetBatchSize(2).SetConcurrency(2))
+
+	resp, err := uc.Lookup(context.Background(), dto.GetApplicationInfoRequest{
+		AdamIDs: []string{"4", "1", "3", "2", "1", "5"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(batches) != 3 {
+		t.Errorf("Expected duplicates to be requested once in 3 batches, got %v", batches)
+	}
+
+	expected := []struct {
+		id     string
+		status dto.LookupStatus
+	}{
+		{"4", dto.LookupStatusFound},
+		{"1", dto.LookupStatusFound},
+		{"3", dto.LookupStatusNotFound},
+		{"2", dto.LookupStatusFound},
+		{"1", dto.LookupStatusFound},
+		{"5", dto.LookupStatusNotAvailable},
+	}
+
+	if len(resp.Results) != len(expected) {
+		t.Fatalf("Expected %d results, got %d", len(expected), len(resp.Results))
+	}
+
+	for i, want := range expected {
+		result := resp.Results[i]
+		if result.ID != want.id || result.Status != want.status {
+			t.Errorf("Result %d: expected %s %s, got %s %s", i, want.id, want.status, result.ID, result.Status)
+		}
+
+		if (result.App != nil) != (want.status != dto.LookupStatusNotFound) {
+			t.Errorf("Result %d: unexpected app %v", i, result.App)
+		}
+
+		if result.App != nil && result.App.AdamID != want.id {
+			t.Errorf("Result %d: expected app %s, got %s", i, want.id, result.App.AdamID)
+		}
+	}
+}
+
+func TestGetApplicationInfo_Lookup_FailedBatch(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockApplicationRepository(ctrl)
+	mockRepo.EXPECT().
+		FindByAdamID(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, adamIDs []string) ([]*entity.Application, error) {
+			if adamIDs[0] == "1" {
+				return nil, errLookupFailed
+			}
+
+			return []*entity.Application{entity.NewApplication(adamIDs[0], "", "")}, nil
+		}).
+		Times(2)
+
+	uc := usecase.NewGetApplicationInfo(mockRepo).SetBatchLookup(service.NewBatchLookup().SetBatchSize(1))
+
+	resp, err := uc.Lookup(context.Background(), dto.GetApplicationInfoRequest{AdamIDs: []string{"1", "2"}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if resp.Results[0].Status != dto.LookupStatusFailed || resp.Results[0].Error != errLookupFailed.Error() {
+		t.Errorf("Expected failed result, got %+v", resp.Results[0])
+	}
+
+	if resp.Results[1].Status != dto.LookupStatusFound {
+		t.Errorf("Expected found result, got %+v", resp.Results[1])
+	}
+}
+
+func TestGetApplicationInfo_Lookup_BundleIDCaseInsensitive(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockApplicationRepository(ctrl)
+	mockRepo.EXPECT().
+		FindByBundleID(gomock.Any(), []string{"com.test.app"}).
+		Return([]*entity.Application{entity.NewApplication("1", "com.Test.App", "App")}, nil)
+
+	uc := usecase.NewGetApplicationInfo(mockRepo)
+
+	resp, err := uc.Lookup(context.Background(), dto.GetApplicationInfoRequest{BundleIDs: []string{"COM.test.app"}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if resp.Results[0].ID != "COM.test.app" || resp.Results[0].Status != dto.LookupStatusFound {
+		t.Errorf("Expected found result for the requested ID, got %+v", resp.Results[0])
+	}
+}
+
+func TestGetApplicationInfo_Execute(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ids := make([]string, 120)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i + 1)
+	}
+
+	mockRepo := mocks.NewMockApplicationRepository(ctrl)
+	mockRepo.EXPECT().
+		FindByAdamID(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, adamIDs []string) ([]*entity.Application, error) {
+			apps := make([]*entity.Application, 0, len(adamIDs))
+			for _, id := range adamIDs {
+				apps = append(apps, entity.NewApplication(id, "", ""))
+			}
+
+			return apps, nil
+		}).
+		Times(3)
+
+	resp, err := usecase.NewGetApplicationInfo(mockRepo).Execute(
+		context.Background(),
+		dto.GetApplicationInfoRequest{AdamIDs: ids},
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(resp.Applications) != len(ids) {
+		t.Fatalf("Expected %d applications, got %d", len(ids), len(resp.Applications))
+	}
+
+	for i, app := range resp.Applications {
+		if app.AdamID != ids[i] {
+			t.Fatalf("Expected application %s at %d, got %s", ids[i], i, app.AdamID)
+		}
+	}
+}
+
+func TestGetApplicationInfo_Execute_Errors(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockApplicationRepository(ctrl)
+	mockRepo.EXPECT().
+		FindByAdamID(gomock.Any(), gomock.Any()).
+		Return(nil, errLookupFailed)
+
+	uc := usecase.NewGetApplicationInfo(mockRepo)
+
+	if _, err := uc.Execute(context.Background(), dto.GetApplicationInfoRequest{AdamIDs: []string{"1"}}); err == nil {
+		t.Error("Expected error for failed lookup")
+	}
+
+	if _, err := uc.Execute(context.Background(), dto.GetApplicationInfoRequest{}); !errors.Is(err, usecase.ErrMissingIdentifiers) {
+		t.Errorf("Expected ErrMissingIdentifiers, got %v", err)
+	}
+}
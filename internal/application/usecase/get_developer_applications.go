@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/domain/repository"
+)
+
+// DefaultDeveloperAppLimit is the default and maximum number of applications of a developer
+// the open API returns. The artist lookup cannot be paged, so the applications of a larger
+// catalog beyond the first DefaultDeveloperAppLimit are not retrieved.
+const DefaultDeveloperAppLimit = 200
+
+// GetDeveloperApplications retrieves a developer profile and the developer's applications.
+// The applications are looked up with GetApplicationInfo, so they carry the same data as
+// regular lookups and long catalogs are fetched in batches.
+type GetDeveloperApplications struct {
+	appRepo    repository.ApplicationRepository
+	getAppInfo *GetApplicationInfo
+}
+
+// NewGetDeveloperApplications creates a new GetDeveloperApplications use case.
+func NewGetDeveloperApplications(
+	appRepo repository.ApplicationRepository,
+	getAppInfo *GetApplicationInfo,
+) *GetDeveloperApplications {
+	return &GetDeveloperApplications{
+		appRepo:    appRepo,
+		getAppInfo: getAppInfo,
+	}
+}
+
+// Execute retrieves the developer and their applications available in the storefront.
+// The developer's AppCount is the number of applications the artist lookup lists, which
+// includes applications the lookup no longer finds in the storefront.
+func (uc *GetDeveloperApplications) Execute(
+	ctx context.Context,
+	req dto.GetByDeveloperRequest,
+) (*dto.GetByDeveloperResponse, error) {
+	limit := req.Limit
+	if limit <= 0 || limit > DefaultDeveloperAppLimit {
+		limit = DefaultDeveloperAppLimit
+	}
+
+	developer, err := uc.appRepo.GetDeveloper(ctx, req.ArtistID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get developer: %w", err)
+	}
+
+	apps := make([]dto.ApplicationDTO, 0)
+
+	if developer.AppCount() > 0 {
+		lookup, err := uc.getAppInfo.lookup(ctx, dto.GetApplicationInfoRequest{AdamIDs: developer.AppAdamIDs})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get developer applications: %w", err)
+		}
+
+		if lookup.firstErr != nil {
+			return nil, fmt.Errorf("failed to get developer applications: %w", lookup.firstErr)
+		}
+
+		// Applications the developer no longer sells in the storefront are left out
+		for _, result := range lookup.resp.Results {
+			if result.Status == dto.LookupStatusFound {
+				apps = append(apps, *result.App)
+			}
+		}
+	}
+
+	return &dto.GetByDeveloperResponse{
+		Developer: dto.DeveloperDTO{
+			ID:       developer.ID,
+			Name:     developer.Name,
+			URL:      developer.URL,
+			AppCount: developer.AppCount(),
+		},
+		Applications: apps,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/application/usecase"
+	"github.com/truewebber/goitunes/v2/internal/domain/entity"
+	"github.com/truewebber/goitunes/v2/internal/domain/repository"
+	"github.com/truewebber/goitunes/v2/internal/domain/repository/mocks"
+	"github.com/truewebber/goitunes/v2/internal/domain/service"
+)
+
+func TestGetDeveloperApplications_Execute(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockApplicationRepository(ctrl)
+	mockRepo.EXPECT().
+		GetDeveloper(gomock.Any(), "42", usecase.DefaultDeveloperAppLimit).
+		Return(&entity.Developer{
+			ID:         "42",
+			Name:       "Test Studio",
+			URL:        "https://apps.apple.com/developer/id42",
+			AppAdamIDs: []string{"1", "2", "3"},
+		}, nil)
+	mockRepo.EXPECT().
+		FindByAdamID(gomock.Any(), []string{"1", "2"}).
+		Return([]*entity.Application{
+			entity.NewApplication("1", "com.test.one", "One").SetArtistID("42"),
+			entity.NewApplication("2", "com.test.two", "Two").SetArtistID("42"),
+		}, nil)
+	mockRepo.EXPECT().
+		FindByAdamID(gomock.Any(), []string{"3"}).
+		Return([]*entity.Application{}, nil)
+
+	getAppInfo := usecase.NewGetApplicationInfo(mockRepo).
+		SetBatchLookup(service.NewBatchLookup().SetBatchSize(2).SetConcurrency(1))
+	uc := usecase.NewGetDeveloperApplications(mockRepo, getAppInfo)
+
+	resp, err := uc.Execute(context.Background(), dto.GetByDeveloperRequest{ArtistID: "42", Limit: 1000})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if resp.Developer.Name != "Test Studio" || resp.Developer.URL == "" {
+		t.Errorf("Unexpected developer: %+v", resp.Developer)
+	}
+
+	if resp.Developer.AppCount != 3 {
+		t.Errorf("Expected the 3 apps the developer lists, got %d", resp.Developer.AppCount)
+	}
+
+	if len(resp.Applications) != 2 || resp.Applications[0].AdamID != "1" || resp.Applications[1].AdamID != "2" {
+		t.Errorf("Unexpected applications: %+v", resp.Applications)
+	}
+}
+
+func TestGetDeveloperApplications_Execute_NotFound(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockApplicationRepository(ctrl)
+	mockRepo.EXPECT().
+		GetDeveloper(gomock.Any(), "42", 10).
+		Return(nil, repository.ErrDeveloperNotFound)
+
+	uc := usecase.NewGetDeveloperApplications(mockRepo, usecase.NewGetApplicationInfo(mockRepo))
+
+	_, err := uc.Execute(context.Background(), dto.GetByDeveloperRequest{ArtistID: "42", Limit: 10})
+	if !errors.Is(err, repository.ErrDeveloperNotFound) {
+		t.Errorf("Expected ErrDeveloperNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/application/mapper"
+	"github.com/truewebber/goitunes/v2/internal/domain/repository"
+)
+
+// GetGenreCatalog retrieves the App Store genre tree of a storefront.
+type GetGenreCatalog struct {
+	chartRepo repository.ChartRepository
+	mapper    *mapper.ApplicationMapper
+	region    string
+}
+
+// NewGetGenreCatalog creates a new GetGenreCatalog use case for the storefront of region.
+func NewGetGenreCatalog(chartRepo repository.ChartRepository, region string) *GetGenreCatalog {
+	return &GetGenreCatalog{
+		chartRepo: chartRepo,
+		mapper:    mapper.NewApplicationMapper(),
+		region:    region,
+	}
+}
+
+// Execute retrieves the genre tree with names in the request language.
+func (uc *GetGenreCatalog) Execute(ctx context.Context) (*dto.GenreCatalogDTO, error) {
+	nodes, err := uc.chartRepo.GetGenres(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get genres: %w", err)
+	}
+
+	return &dto.GenreCatalogDTO{
+		RefreshedAt: time.Now().UTC(),
+		Region:      uc.region,
+		Genres:      uc.mapper.GenreNodesToDTOList(nodes),
+	}, nil
+}
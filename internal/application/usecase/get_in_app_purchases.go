@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/application/mapper"
+	"github.com/truewebber/goitunes/v2/internal/domain/repository"
+)
+
+// GetInAppPurchases retrieves the in-app purchases of an application in a storefront.
+type GetInAppPurchases struct {
+	appRepo    repository.ApplicationRepository
+	mapper     *mapper.ApplicationMapper
+	storefront string
+}
+
+// NewGetInAppPurchases creates a new GetInAppPurchases use case.
+func NewGetInAppPurchases(appRepo repository.ApplicationRepository, storefront string) *GetInAppPurchases {
+	return &GetInAppPurchases{
+		appRepo:    appRepo,
+		mapper:     mapper.NewApplicationMapper(),
+		storefront: storefront,
+	}
+}
+
+// Execute retrieves the in-app purchases of the application with adamID.
+func (uc *GetInAppPurchases) Execute(ctx context.Context, adamID string) (*dto.GetInAppPurchasesResponse, error) {
+	purchases, err := uc.appRepo.GetInAppPurchases(ctx, adamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get in-app purchases: %w", err)
+	}
+
+	return &dto.GetInAppPurchasesResponse{
+		AdamID:         adamID,
+		Storefront:     uc.storefront,
+		InAppPurchases: uc.mapper.InAppPurchasesToDTOList(purchases),
+	}, nil
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/application/mapper"
+	"github.com/truewebber/goitunes/v2/internal/domain/repository"
+)
+
+// GetPrivacyDetails retrieves the App Privacy label of an application.
+type GetPrivacyDetails struct {
+	appRepo repository.ApplicationRepository
+	mapper  *mapper.ApplicationMapper
+}
+
+// NewGetPrivacyDetails creates a new GetPrivacyDetails use case.
+func NewGetPrivacyDetails(appRepo repository.ApplicationRepository) *GetPrivacyDetails {
+	return &GetPrivacyDetails{
+		appRepo: appRepo,
+		mapper:  mapper.NewApplicationMapper(),
+	}
+}
+
+// Execute retrieves the App Privacy label of the application with adamID.
+func (uc *GetPrivacyDetails) Execute(ctx context.Context, adamID string) (*dto.PrivacyDetailsDTO, error) {
+	details, err := uc.appRepo.GetPrivacyDetails(ctx, adamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get privacy details: %w", err)
+	}
+
+	resp := uc.mapper.PrivacyDetailsToDTO(adamID, details)
+
+	return &resp, nil
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/application/mapper"
+	"github.com/truewebber/goitunes/v2/internal/domain/repository"
+)
+
+// GetRelated retrieves the applications listed as related on an application page.
+type GetRelated struct {
+	appRepo repository.ApplicationRepository
+	mapper  *mapper.ApplicationMapper
+}
+
+// NewGetRelated creates a new GetRelated use case.
+func NewGetRelated(appRepo repository.ApplicationRepository) *GetRelated {
+	return &GetRelated{
+		appRepo: appRepo,
+		mapper:  mapper.NewApplicationMapper(),
+	}
+}
+
+// Execute retrieves the related application sections of the application with adamID.
+func (uc *GetRelated) Execute(ctx context.Context, adamID string) (*dto.GetRelatedResponse, error) {
+	related, err := uc.appRepo.GetRelated(ctx, adamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get related applications: %w", err)
+	}
+
+	sections := make([]dto.RelatedSectionDTO, 0, len(related))
+	for _, section := range related {
+		sections = append(sections, dto.RelatedSectionDTO{
+			Section:      dto.RelatedSection(section.Section),
+			Applications: uc.mapper.ToDTOList(section.Applications),
+		})
+	}
+
+	return &dto.GetRelatedResponse{
+		AdamID:   adamID,
+		Sections: sections,
+	}, nil
+}
@@ -26,9 +26,25 @@ func NewGetTopCharts(chartRepo repository.ChartRepository) *GetTopCharts {
 
 // Execute retrieves top charts.
 func (uc *GetTopCharts) Execute(ctx context.Context, req *dto.GetTopChartsRequest) (*dto.GetTopChartsResponse, error) {
-	chartType := uc.parseChartType(req.ChartType)
+	chart, filtered, err := uc.chart(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GetTopChartsResponse{
+		Items:      uc.mapper.ChartItemsToDTOList(chart.Items()),
+		Unresolved: uc.mapper.UnresolvedChartItemsToDTOList(chart.Errors()),
+		TotalCount: chart.TotalCount(),
+		Filtered:   filtered,
+	}, nil
+}
 
-	var items []*entity.ChartItem
+// chart retrieves the chart of req and applies its age filter.
+// It returns the number of items the filter dropped.
+func (uc *GetTopCharts) chart(ctx context.Context, req *dto.GetTopChartsRequest) (*entity.Chart, int, error) {
+	chartType := parseChartType(req.ChartType)
+
+	var chart *entity.Chart
 
 	var err error
 
@@ -38,19 +54,32 @@ func (uc *GetTopCharts) Execute(ctx context.Context, req *dto.GetTopChartsReques
 	useTop1500 := req.MaxResults > top200Limit || req.Page > 0
 
 	if useTop1500 {
-		items, err = uc.getTop1500(ctx, req, chartType)
+		chart, err = uc.getTop1500(ctx, req, chartType)
 	} else {
-		items, err = uc.getTop200(ctx, req, chartType)
+		chart, err = uc.getTop200(ctx, req, chartType)
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to get charts: %w", err)
+		return nil, 0, fmt.Errorf("failed to get charts: %w", err)
 	}
 
-	return &dto.GetTopChartsResponse{
-		Items:      uc.mapper.ChartItemsToDTOList(items),
-		TotalCount: len(items),
-	}, nil
+	filtered := 0
+	if req.MaxAge > 0 {
+		filtered = chart.FilterItems(suitableFor(req.MaxAge))
+	}
+
+	return chart, filtered, nil
+}
+
+// suitableFor keeps chart items whose application is rated for age.
+// Applications without a content rating or without an age in it are dropped,
+// since their suitability is unknown.
+func suitableFor(age int) func(item *entity.ChartItem) bool {
+	return func(item *entity.ChartItem) bool {
+		rating := item.Application().ContentRating()
+
+		return rating != nil && rating.SuitableFor(age)
+	}
 }
 
 // getTop1500 retrieves top 1500 charts.
@@ -58,7 +87,7 @@ func (uc *GetTopCharts) getTop1500(
 	ctx context.Context,
 	req *dto.GetTopChartsRequest,
 	chartType entity.ChartType,
-) ([]*entity.ChartItem, error) {
+) (*entity.Chart, error) {
 	page := req.Page
 	if page < 0 {
 		page = 0
@@ -74,7 +103,9 @@ func (uc *GetTopCharts) getTop1500(
 		return nil, fmt.Errorf("failed to get top 1500 charts: %w", err)
 	}
 
-	return items, nil
+	// The top 1500 service does not report the length of the chart,
+	// so the total count is the length of the page.
+	return entity.NewChart(items), nil
 }
 
 // getTop200 retrieves top 200 charts.
@@ -82,7 +113,7 @@ func (uc *GetTopCharts) getTop200(
 	ctx context.Context,
 	req *dto.GetTopChartsRequest,
 	chartType entity.ChartType,
-) ([]*entity.ChartItem, error) {
+) (*entity.Chart, error) {
 	from := req.From
 	if from < 1 {
 		from = 1
@@ -96,16 +127,17 @@ func (uc *GetTopCharts) getTop200(
 		limit = defaultTop200Limit
 	}
 
-	items, err := uc.chartRepo.GetTop200(ctx, req.GenreID, chartType, req.KidPrefix, from, limit)
+	chart, err := uc.chartRepo.GetTop200(ctx, req.GenreID, chartType, req.KidPrefix, from, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get top 200 charts: %w", err)
 	}
 
-	return items, nil
+	return chart, nil
 }
 
 // parseChartType converts string to ChartType.
-func (uc *GetTopCharts) parseChartType(chartType string) entity.ChartType {
+// Unknown chart types fall back to the top free chart.
+func parseChartType(chartType string) entity.ChartType {
 	switch chartType {
 	case "topfree":
 		return entity.ChartTypeTopFree
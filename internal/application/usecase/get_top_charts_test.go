@@ -0,0 +1,176 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/application/usecase"
+	"github.com/truewebber/goitunes/v2/internal/domain/entity"
+	"github.com/truewebber/goitunes/v2/internal/domain/repository/mocks"
+	"github.com/truewebber/goitunes/v2/internal/domain/valueobject"
+)
+
+var errLookupFailed = errors.New("lookup failed")
+
+func TestGetTopCharts_Execute_PartialChart(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chart := entity.NewChart([]*entity.ChartItem{
+		entity.NewChartItem(entity.NewApplication("1", "com.test.one", "One"), 1, entity.ChartTypeTopFree),
+	})
+	chart.AddError(&entity.ChartItemError{AdamID: "2", Position: 2, Err: errLookupFailed})
+	chart.AddError(&entity.ChartItemError{AdamID: "3", Position: 3, Err: entity.ErrChartItemNotFound})
+	chart.SetTotalCount(200)
+
+	mockRepo := mocks.NewMockChartRepository(ctrl)
+	mockRepo.EXPECT().
+		GetTop200(gomock.Any(), "36", entity.ChartTypeTopFree, "", 1, 200).
+		Return(chart, nil)
+
+	uc := usecase.NewGetTopCharts(mockRepo)
+
+	resp, err := uc.Execute(context.Background(), &dto.GetTopChartsRequest{
+		GenreID:   "36",
+		ChartType: "topfree",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(resp.Items) != 1 || resp.Items[0].App.AdamID != "1" {
+		t.Errorf("Expected one resolved item, got %v", resp.Items)
+	}
+
+	if resp.TotalCount != 200 {
+		t.Errorf("Expected total count 200, got %d", resp.TotalCount)
+	}
+
+	if len(resp.Unresolved) != 2 {
+		t.Fatalf("Expected two unresolved positions, got %d", len(resp.Unresolved))
+	}
+
+	if resp.Unresolved[0].AdamID != "2" || resp.Unresolved[0].Position != 2 {
+		t.Errorf("Unexpected unresolved position: %+v", resp.Unresolved[0])
+	}
+
+	if resp.Unresolved[1].Reason != entity.ErrChartItemNotFound.Error() {
+		t.Errorf("Expected not found reason, got %q", resp.Unresolved[1].Reason)
+	}
+}
+
+func TestGetTopCharts_Execute_Top1500(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockChartRepository(ctrl)
+	mockRepo.EXPECT().
+		GetTop1500(gomock.Any(), "36", entity.ChartTypeTopPaid, 2, 100).
+		Return([]*entity.ChartItem{
+			entity.NewChartItem(entity.NewApplication("1", "com.test.one", "One"), 201, entity.ChartTypeTopPaid),
+			entity.NewChartItem(entity.NewApplication("2", "com.test.two", "Two"), 202, entity.ChartTypeTopPaid),
+		}, nil)
+
+	uc := usecase.NewGetTopCharts(mockRepo)
+
+	resp, err := uc.Execute(context.Background(), &dto.GetTopChartsRequest{
+		GenreID:    "36",
+		ChartType:  "toppaid",
+		Page:       2,
+		MaxResults: 100,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(resp.Items) != 2 || resp.Items[1].Position != 202 {
+		t.Errorf("Unexpected items %v", resp.Items)
+	}
+
+	if resp.TotalCount != 2 {
+		t.Errorf("Expected the page length as total count, got %d", resp.TotalCount)
+	}
+}
+
+func TestGetTopCharts_Execute_RepositoryError(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockChartRepository(ctrl)
+	mockRepo.EXPECT().
+		GetTop200(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, context.Canceled)
+
+	uc := usecase.NewGetTopCharts(mockRepo)
+
+	resp, err := uc.Execute(context.Background(), &dto.GetTopChartsRequest{GenreID: "36"})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+
+	if resp != nil {
+		t.Error("Response should be nil for error case")
+	}
+}
+
+func TestGetTopCharts_Execute_MaxAge(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ratedApp := func(adamID, label string) *entity.Application {
+		rating, err := valueobject.NewContentRating(valueobject.ContentRatingSystemApple, label, 1, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		return entity.NewApplication(adamID, "com.test."+adamID, adamID).SetContentRating(rating)
+	}
+
+	chart := entity.NewChart([]*entity.ChartItem{
+		entity.NewChartItem(ratedApp("1", "4+"), 1, entity.ChartTypeTopFree),
+		entity.NewChartItem(ratedApp("2", "12+"), 2, entity.ChartTypeTopFree),
+		entity.NewChartItem(entity.NewApplication("3", "com.test.3", "3"), 3, entity.ChartTypeTopFree),
+		entity.NewChartItem(ratedApp("4", "9+"), 4, entity.ChartTypeTopFree),
+		entity.NewChartItem(ratedApp("5", "Not yet rated"), 5, entity.ChartTypeTopFree),
+	})
+
+	mockRepo := mocks.NewMockChartRepository(ctrl)
+	mockRepo.EXPECT().
+		GetTop200(gomock.Any(), "6014", entity.ChartTypeTopFree, "", 1, 200).
+		Return(chart, nil)
+
+	uc := usecase.NewGetTopCharts(mockRepo)
+
+	resp, err := uc.Execute(context.Background(), &dto.GetTopChartsRequest{
+		GenreID:   "6014",
+		ChartType: "topfree",
+		MaxAge:    9,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(resp.Items) != 2 || resp.Items[0].App.AdamID != "1" || resp.Items[1].App.AdamID != "4" {
+		t.Errorf("Expected apps 1 and 4, got %v", resp.Items)
+	}
+
+	if resp.Items[1].Position != 4 {
+		t.Errorf("Expected chart position to be kept, got %d", resp.Items[1].Position)
+	}
+
+	if resp.Filtered != 3 {
+		t.Errorf("Expected 3 filtered apps, got %d", resp.Filtered)
+	}
+}
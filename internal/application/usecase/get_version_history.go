@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/application/mapper"
+	"github.com/truewebber/goitunes/v2/internal/domain/repository"
+)
+
+// GetVersionHistory retrieves the released versions of an application.
+type GetVersionHistory struct {
+	appRepo repository.ApplicationRepository
+	mapper  *mapper.ApplicationMapper
+}
+
+// NewGetVersionHistory creates a new GetVersionHistory use case.
+func NewGetVersionHistory(appRepo repository.ApplicationRepository) *GetVersionHistory {
+	return &GetVersionHistory{
+		appRepo: appRepo,
+		mapper:  mapper.NewApplicationMapper(),
+	}
+}
+
+// Execute retrieves the version history of the application with adamID, oldest first.
+func (uc *GetVersionHistory) Execute(ctx context.Context, adamID string) (*dto.GetVersionHistoryResponse, error) {
+	app, err := uc.appRepo.GetFullInfo(ctx, adamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get version history: %w", err)
+	}
+
+	return &dto.GetVersionHistoryResponse{
+		AdamID:   adamID,
+		Versions: uc.mapper.VersionHistoryToDTOList(app.VersionHistory()),
+	}, nil
+}
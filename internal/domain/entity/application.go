@@ -1,11 +1,17 @@
 package entity
 
-import "time"
+import (
+	"slices"
+	"time"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/valueobject"
+)
 
 // Application represents an iOS application in the App Store.
 type Application struct {
 	releaseDate      time.Time
 	description      string
+	releaseNotes     string
 	currency         string
 	artistName       string
 	artistID         string
@@ -17,22 +23,35 @@ type Application struct {
 	adamID           string
 	bundleID         string
 	genreID          string
+	copyright        string
+	locale           string
 	screenshotURLs   []string
 	deviceFamilies   []string
+	genres           []Genre
+	offers           []Offer
+	versionHistory   []Version
+	fileSizeByDevice map[string]int64
+	artwork          Artwork
+	contentRating    *valueobject.ContentRating
 	ratingCount      int
 	fileSize         int64
 	rating           float64
 	price            float64
 	versionID        int64
+	unavailable      bool
 }
 
 func NewApplication(adamID, bundleID, name string) *Application {
 	return &Application{
-		adamID:         adamID,
-		bundleID:       bundleID,
-		name:           name,
-		deviceFamilies: make([]string, 0),
-		screenshotURLs: make([]string, 0),
+		adamID:           adamID,
+		bundleID:         bundleID,
+		name:             name,
+		deviceFamilies:   make([]string, 0),
+		screenshotURLs:   make([]string, 0),
+		genres:           make([]Genre, 0),
+		offers:           make([]Offer, 0),
+		versionHistory:   make([]Version, 0),
+		fileSizeByDevice: make(map[string]int64),
 	}
 }
 
@@ -57,6 +76,39 @@ func (a *Application) Description() string      { return a.description }
 func (a *Application) IconURL() string          { return a.iconURL }
 func (a *Application) ScreenshotURLs() []string { return a.screenshotURLs }
 
+// Genres returns every genre the application is listed in, the primary genre first.
+func (a *Application) Genres() []Genre { return a.genres }
+
+// Offers returns every offer of the application in the storefront.
+func (a *Application) Offers() []Offer { return a.offers }
+
+// DeviceFamilySet returns the device families as a typed set, e.g. to check SupportsMac().
+func (a *Application) DeviceFamilySet() valueobject.DeviceFamilies {
+	return valueobject.NewDeviceFamilies(a.deviceFamilies)
+}
+
+// ReleaseNotes returns the "What's New" text of the current version.
+func (a *Application) ReleaseNotes() string { return a.releaseNotes }
+
+// VersionHistory returns the released versions, oldest first.
+// It is only known for applications fetched with their full product page.
+func (a *Application) VersionHistory() []Version { return a.versionHistory }
+
+// FileSizeByDevice returns the download size in bytes per device model.
+func (a *Application) FileSizeByDevice() map[string]int64 { return a.fileSizeByDevice }
+
+// Artwork returns the icon template of the application.
+func (a *Application) Artwork() Artwork { return a.artwork }
+
+// Copyright returns the copyright notice of the application.
+func (a *Application) Copyright() string { return a.copyright }
+
+// ContentRating returns the age rating of the application, or nil if the store did not report one.
+func (a *Application) ContentRating() *valueobject.ContentRating { return a.contentRating }
+
+// Locale returns the BCP 47 tag of the language the textual metadata is in.
+func (a *Application) Locale() string { return a.locale }
+
 func (a *Application) SetArtistName(name string) *Application {
 	a.artistName = name
 
@@ -103,6 +155,69 @@ func (a *Application) SetGenre(id, name string) *Application {
 	return a
 }
 
+// SetGenres sets every genre of the application and makes the first one the primary genre.
+func (a *Application) SetGenres(genres []Genre) *Application {
+	a.genres = genres
+
+	if len(genres) > 0 {
+		a.genreID = genres[0].ID
+		a.genreName = genres[0].Name
+	}
+
+	return a
+}
+
+func (a *Application) SetOffers(offers []Offer) *Application {
+	a.offers = offers
+
+	return a
+}
+
+func (a *Application) SetFileSizeByDevice(sizes map[string]int64) *Application {
+	a.fileSizeByDevice = sizes
+
+	return a
+}
+
+// SetArtwork sets the icon template. The icon URL defaults to the template's
+// URL at its original size when it is not set yet.
+func (a *Application) SetArtwork(artwork Artwork) *Application {
+	a.artwork = artwork
+
+	if a.iconURL == "" && artwork.URLTemplate != "" {
+		a.iconURL = artwork.URL(artwork.Width, artwork.Height, "png")
+	}
+
+	return a
+}
+
+func (a *Application) SetReleaseNotes(notes string) *Application {
+	a.releaseNotes = notes
+
+	return a
+}
+
+// SetVersionHistory sets the released versions, sorting them oldest first.
+func (a *Application) SetVersionHistory(versions []Version) *Application {
+	a.versionHistory = slices.SortedStableFunc(slices.Values(versions), func(x, y Version) int {
+		return x.ReleaseDate.Compare(y.ReleaseDate)
+	})
+
+	return a
+}
+
+func (a *Application) SetCopyright(copyright string) *Application {
+	a.copyright = copyright
+
+	return a
+}
+
+func (a *Application) SetContentRating(rating *valueobject.ContentRating) *Application {
+	a.contentRating = rating
+
+	return a
+}
+
 func (a *Application) SetDeviceFamilies(families []string) *Application {
 	a.deviceFamilies = families
 
@@ -139,23 +254,28 @@ func (a *Application) SetScreenshotURLs(urls []string) *Application {
 	return a
 }
 
-func (a *Application) IsFree() bool {
-	return a.price == 0
+func (a *Application) SetLocale(locale string) *Application {
+	a.locale = locale
+
+	return a
 }
 
-func (a *Application) IsUniversal() bool {
-	hasIPhone := false
-	hasIPad := false
+// SetAvailable marks whether the application is sold in the storefront it was looked up in.
+func (a *Application) SetAvailable(available bool) *Application {
+	a.unavailable = !available
 
-	for _, family := range a.deviceFamilies {
-		if family == "iphone" {
-			hasIPhone = true
-		}
+	return a
+}
 
-		if family == "ipad" {
-			hasIPad = true
-		}
-	}
+func (a *Application) IsFree() bool {
+	return a.price == 0
+}
 
-	return hasIPhone && hasIPad
+// IsAvailable reports whether the application is sold in the storefront it was looked up in.
+func (a *Application) IsAvailable() bool {
+	return !a.unavailable
+}
+
+func (a *Application) IsUniversal() bool {
+	return a.DeviceFamilySet().IsUniversal()
 }
@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Genre represents an App Store genre an application is listed in.
+type Genre struct {
+	ID   string
+	Name string
+	URL  string
+}
+
+// GenreNode represents a genre of the App Store genre tree.
+type GenreNode struct {
+	ID       string
+	Name     string
+	ParentID string // Empty for the root genre
+}
+
+// Offer represents a way to buy or download an application.
+type Offer struct {
+	Type           string
+	PriceFormatted string
+	Currency       string
+	BuyParams      string
+	Version        string
+	Assets         []OfferAsset
+	Price          float64
+	VersionID      int64
+}
+
+// OfferAsset represents a downloadable asset of an offer.
+type OfferAsset struct {
+	Flavor string
+	Size   int64
+}
+
+// Version represents a released version of an application.
+type Version struct {
+	ReleaseDate  time.Time
+	Version      string
+	ReleaseNotes string
+}
+
+// Artwork represents an image template with the dimensions of the original image.
+// The template contains {w}, {h}, {c} and {f} placeholders for width, height,
+// crop and format, e.g. ".../{w}x{h}{c}.{f}".
+type Artwork struct {
+	URLTemplate string
+	Width       int
+	Height      int
+}
+
+// URL returns the artwork URL for the given size and format, e.g. URL(512, 512, "png").
+func (a Artwork) URL(width, height int, format string) string {
+	return strings.NewReplacer(
+		"{w}", strconv.Itoa(width),
+		"{h}", strconv.Itoa(height),
+		"{c}", "bb",
+		"{f}", format,
+	).Replace(a.URLTemplate)
+}
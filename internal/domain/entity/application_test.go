@@ -99,6 +99,23 @@ func TestApplication_IsFree(t *testing.T) {
 	}
 }
 
+func TestApplication_IsAvailable(t *testing.T) {
+	t.Parallel()
+
+	app := entity.NewApplication("123", "com.test", "Test")
+	if !app.IsAvailable() {
+		t.Error("Expected new application to be available")
+	}
+
+	if app.SetAvailable(false).IsAvailable() {
+		t.Error("Expected application to be unavailable")
+	}
+
+	if !app.SetAvailable(true).IsAvailable() {
+		t.Error("Expected application to be available again")
+	}
+}
+
 func TestApplication_IsUniversal(t *testing.T) {
 	t.Parallel()
 
@@ -536,3 +553,59 @@ func TestApplication_AllSetters(t *testing.T) {
 		t.Error("ScreenshotURLs not set")
 	}
 }
+
+func TestApplication_SetGenres(t *testing.T) {
+	t.Parallel()
+
+	app := entity.NewApplication("123", "com.test", "Test")
+	app.SetGenres([]entity.Genre{
+		{ID: "6014", Name: "Games"},
+		{ID: "7003", Name: "Casual"},
+	})
+
+	if len(app.Genres()) != 2 {
+		t.Fatalf("Expected 2 genres, got %d", len(app.Genres()))
+	}
+
+	if app.GenreID() != "6014" || app.GenreName() != "Games" {
+		t.Errorf("Expected primary genre 6014 Games, got %s %s", app.GenreID(), app.GenreName())
+	}
+}
+
+func TestArtwork_URL(t *testing.T) {
+	t.Parallel()
+
+	artwork := entity.Artwork{
+		URLTemplate: "https://is1-ssl.mzstatic.com/image/thumb/icon/{w}x{h}{c}.{f}",
+		Width:       1024,
+		Height:      1024,
+	}
+
+	expected := "https://is1-ssl.mzstatic.com/image/thumb/icon/512x512bb.jpg"
+	if got := artwork.URL(512, 512, "jpg"); got != expected {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+
+	app := entity.NewApplication("123", "com.test", "Test").SetArtwork(artwork)
+
+	expected = "https://is1-ssl.mzstatic.com/image/thumb/icon/1024x1024bb.png"
+	if app.IconURL() != expected {
+		t.Errorf("Expected icon URL %s, got %s", expected, app.IconURL())
+	}
+}
+
+func TestApplication_SetVersionHistory(t *testing.T) {
+	t.Parallel()
+
+	app := entity.NewApplication("1", "com.test.app", "Test")
+	app.SetVersionHistory([]entity.Version{
+		{Version: "2.0", ReleaseDate: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{Version: "1.0", ReleaseDate: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Version: "1.1", ReleaseDate: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)},
+	})
+
+	history := app.VersionHistory()
+	if len(history) != 3 || history[0].Version != "1.0" || history[1].Version != "1.1" || history[2].Version != "2.0" {
+		t.Errorf("Expected versions oldest first, got %+v", history)
+	}
+}
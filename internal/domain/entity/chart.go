@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Chart represents a resolved range of an App Store chart.
+// A chart may be partial: positions whose application info could not be
+// fetched are reported in Errors instead of failing the whole chart.
+type Chart struct {
+	items      []*ChartItem
+	errors     []*ChartItemError
+	totalCount int
+}
+
+// ChartItemError describes a chart position whose application could not be resolved.
+// Err is ErrChartItemNotFound when the store returned no info for the position.
+type ChartItemError struct {
+	Err      error
+	AdamID   string
+	Position int
+}
+
+// NewChart creates a chart of items. The total count starts as the number of items;
+// use SetTotalCount when the items are a range of a longer chart.
+func NewChart(items []*ChartItem) *Chart {
+	if items == nil {
+		items = make([]*ChartItem, 0)
+	}
+
+	return &Chart{
+		items:      items,
+		errors:     make([]*ChartItemError, 0),
+		totalCount: len(items),
+	}
+}
+
+func (c *Chart) Items() []*ChartItem       { return c.items }
+func (c *Chart) Errors() []*ChartItemError { return c.errors }
+
+// TotalCount returns the number of entries in the whole chart,
+// which may exceed the number of requested or resolved items.
+func (c *Chart) TotalCount() int { return c.totalCount }
+
+// AddItem appends a resolved chart entry.
+func (c *Chart) AddItem(item *ChartItem) *Chart {
+	c.items = append(c.items, item)
+
+	return c
+}
+
+// SetTotalCount sets the number of entries in the whole chart.
+func (c *Chart) SetTotalCount(count int) *Chart {
+	c.totalCount = count
+
+	return c
+}
+
+// AddError records a chart position whose application could not be resolved.
+func (c *Chart) AddError(itemErr *ChartItemError) *Chart {
+	c.errors = append(c.errors, itemErr)
+
+	return c
+}
+
+// FilterItems keeps only the items for which keep returns true
+// and returns the number of items removed.
+func (c *Chart) FilterItems(keep func(item *ChartItem) bool) int {
+	before := len(c.items)
+	c.items = slices.DeleteFunc(c.items, func(item *ChartItem) bool { return !keep(item) })
+
+	return before - len(c.items)
+}
+
+// IsPartial returns true if at least one chart position could not be resolved.
+func (c *Chart) IsPartial() bool {
+	return len(c.errors) > 0
+}
+
+// Error implements the error interface.
+func (e *ChartItemError) Error() string {
+	return fmt.Sprintf("chart position %d (adamID %s): %v", e.Position, e.AdamID, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ChartItemError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// ChartKey identifies a chart whose history is tracked. Charts limited to a Kids
+// age band or filtered by age rating are tracked apart from the full chart.
+type ChartKey struct {
+	Storefront string // Region code, e.g. "us"
+	GenreID    string
+	ChartType  ChartType
+	Device     string // Platform name, e.g. "iphone"
+	AgeBand    string // Kids age band, empty for all ages
+	MaxAge     int    // Age rating filter, 0 when unfiltered
+}
+
+// ChartSnapshot represents a chart captured at a point in time.
+type ChartSnapshot struct {
+	Key        ChartKey
+	CapturedAt time.Time
+	Chart      *Chart
+}
+
+// RankPoint represents the position of an application in a chart captured at a point in time.
+type RankPoint struct {
+	CapturedAt time.Time
+	Currency   string
+	Position   int
+	Price      float64
+}
@@ -0,0 +1,109 @@
+package entity_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/entity"
+)
+
+var errLookupFailed = errors.New("lookup failed")
+
+func TestNewChart(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil items", func(t *testing.T) {
+		t.Parallel()
+
+		chart := entity.NewChart(nil)
+
+		if chart.Items() == nil {
+			t.Error("Items should not be nil")
+		}
+
+		if len(chart.Errors()) != 0 {
+			t.Errorf("Expected no errors, got %d", len(chart.Errors()))
+		}
+
+		if chart.IsPartial() {
+			t.Error("Empty chart should not be partial")
+		}
+	})
+
+	t.Run("with items", func(t *testing.T) {
+		t.Parallel()
+
+		items := []*entity.ChartItem{
+			entity.NewChartItem(entity.NewApplication("1", "com.test.one", "One"), 1, entity.ChartTypeTopFree),
+			entity.NewChartItem(entity.NewApplication("2", "com.test.two", "Two"), 2, entity.ChartTypeTopFree),
+		}
+
+		chart := entity.NewChart(items)
+
+		if len(chart.Items()) != len(items) {
+			t.Errorf("Expected %d items, got %d", len(items), len(chart.Items()))
+		}
+	})
+}
+
+func TestChart_AddError(t *testing.T) {
+	t.Parallel()
+
+	chart := entity.NewChart(nil)
+	itemErr := &entity.ChartItemError{AdamID: "123", Position: 57, Err: errLookupFailed}
+
+	result := chart.AddError(itemErr)
+
+	if result != chart {
+		t.Error("AddError should return the same instance")
+	}
+
+	if !chart.IsPartial() {
+		t.Error("Chart with errors should be partial")
+	}
+
+	if len(chart.Errors()) != 1 || chart.Errors()[0] != itemErr {
+		t.Errorf("Expected the added error, got %v", chart.Errors())
+	}
+}
+
+func TestChartItemError(t *testing.T) {
+	t.Parallel()
+
+	itemErr := &entity.ChartItemError{AdamID: "123", Position: 57, Err: errLookupFailed}
+
+	if !errors.Is(itemErr, errLookupFailed) {
+		t.Error("ChartItemError should unwrap to the underlying error")
+	}
+
+	want := "chart position 57 (adamID 123): lookup failed"
+	if itemErr.Error() != want {
+		t.Errorf("Expected %q, got %q", want, itemErr.Error())
+	}
+}
+
+func TestChart_TotalCount(t *testing.T) {
+	t.Parallel()
+
+	chart := entity.NewChart(nil)
+	chart.AddItem(entity.NewChartItem(entity.NewApplication("1", "com.test.one", "One"), 1, entity.ChartTypeTopFree))
+	chart.AddError(&entity.ChartItemError{AdamID: "2", Position: 2, Err: entity.ErrChartItemNotFound})
+
+	if chart.TotalCount() != 0 {
+		t.Errorf("Expected total count 0 before it is set, got %d", chart.TotalCount())
+	}
+
+	chart.SetTotalCount(200)
+
+	if chart.TotalCount() != 200 {
+		t.Errorf("Expected total count 200, got %d", chart.TotalCount())
+	}
+
+	if len(chart.Items()) != 1 {
+		t.Errorf("Expected 1 item, got %d", len(chart.Items()))
+	}
+
+	if !errors.Is(chart.Errors()[0], entity.ErrChartItemNotFound) {
+		t.Error("Unresolved position should wrap ErrChartItemNotFound")
+	}
+}
@@ -0,0 +1,14 @@
+package entity
+
+// Developer represents an App Store developer (artist) and their applications in a storefront.
+type Developer struct {
+	ID         string
+	Name       string
+	URL        string
+	AppAdamIDs []string
+}
+
+// AppCount returns the number of applications of the developer.
+func (d *Developer) AppCount() int {
+	return len(d.AppAdamIDs)
+}
@@ -0,0 +1,8 @@
+package entity
+
+import "errors"
+
+var (
+	// ErrChartItemNotFound is returned when a chart position has no application info in the store response.
+	ErrChartItemNotFound = errors.New("application info not found")
+)
@@ -0,0 +1,32 @@
+package entity
+
+// InAppPurchaseType represents the kind of an in-app purchase.
+type InAppPurchaseType string
+
+const (
+	InAppPurchaseTypeConsumable    InAppPurchaseType = "consumable"
+	InAppPurchaseTypeNonConsumable InAppPurchaseType = "non-consumable"
+	InAppPurchaseTypeAutoRenewable InAppPurchaseType = "auto-renewable-subscription"
+	InAppPurchaseTypeNonRenewing   InAppPurchaseType = "non-renewing-subscription"
+	InAppPurchaseTypeUnknown       InAppPurchaseType = "unknown"
+)
+
+// String returns the string representation of the in-app purchase type.
+func (t InAppPurchaseType) String() string {
+	return string(t)
+}
+
+// IsSubscription reports whether the in-app purchase is a subscription.
+func (t InAppPurchaseType) IsSubscription() bool {
+	return t == InAppPurchaseTypeAutoRenewable || t == InAppPurchaseTypeNonRenewing
+}
+
+// InAppPurchase represents an in-app purchase the App Store lists for an application.
+type InAppPurchase struct {
+	AdamID         string
+	Name           string
+	Type           InAppPurchaseType
+	PriceFormatted string
+	Currency       string
+	Price          float64
+}
@@ -0,0 +1,86 @@
+package entity
+
+// PrivacyType represents a section of the App Privacy label.
+type PrivacyType string
+
+const (
+	PrivacyTypeUsedToTrackYou PrivacyType = "used_to_track_you"
+	PrivacyTypeLinkedToYou    PrivacyType = "linked_to_you"
+	PrivacyTypeNotLinkedToYou PrivacyType = "not_linked_to_you"
+	PrivacyTypeNotCollected   PrivacyType = "not_collected"
+	PrivacyTypeUnknown        PrivacyType = "unknown"
+)
+
+// PrivacyCategory represents a category of data an application collects.
+type PrivacyCategory string
+
+const (
+	PrivacyCategoryContactInfo      PrivacyCategory = "contact_info"
+	PrivacyCategoryHealthAndFitness PrivacyCategory = "health_and_fitness"
+	PrivacyCategoryFinancialInfo    PrivacyCategory = "financial_info"
+	PrivacyCategoryLocation         PrivacyCategory = "location"
+	PrivacyCategorySensitiveInfo    PrivacyCategory = "sensitive_info"
+	PrivacyCategoryContacts         PrivacyCategory = "contacts"
+	PrivacyCategoryUserContent      PrivacyCategory = "user_content"
+	PrivacyCategoryBrowsingHistory  PrivacyCategory = "browsing_history"
+	PrivacyCategorySearchHistory    PrivacyCategory = "search_history"
+	PrivacyCategoryIdentifiers      PrivacyCategory = "identifiers"
+	PrivacyCategoryPurchases        PrivacyCategory = "purchases"
+	PrivacyCategoryUsageData        PrivacyCategory = "usage_data"
+	PrivacyCategoryDiagnostics      PrivacyCategory = "diagnostics"
+	PrivacyCategoryOther            PrivacyCategory = "other"
+	PrivacyCategoryUnknown          PrivacyCategory = "unknown"
+)
+
+// PrivacyPurpose represents a reason an application collects data.
+type PrivacyPurpose string
+
+const (
+	PrivacyPurposeThirdPartyAdvertising  PrivacyPurpose = "third_party_advertising"
+	PrivacyPurposeDevelopersAdvertising  PrivacyPurpose = "developers_advertising"
+	PrivacyPurposeAnalytics              PrivacyPurpose = "analytics"
+	PrivacyPurposeProductPersonalization PrivacyPurpose = "product_personalization"
+	PrivacyPurposeAppFunctionality       PrivacyPurpose = "app_functionality"
+	PrivacyPurposeOther                  PrivacyPurpose = "other"
+	PrivacyPurposeUnknown                PrivacyPurpose = "unknown"
+)
+
+// PrivacyDetails represents the App Privacy label of an application.
+type PrivacyDetails struct {
+	PolicyURL        string
+	ManageChoicesURL string
+	Sections         []PrivacySection
+}
+
+// PrivacySection represents one section of the App Privacy label, e.g. data linked to you.
+// Categories lists every category of the section; sections that state purposes
+// also list the categories collected for each purpose.
+type PrivacySection struct {
+	Type       PrivacyType
+	Categories []PrivacyCategory
+	Purposes   []PrivacyPurposeCategories
+}
+
+// PrivacyPurposeCategories represents the categories of data collected for a purpose.
+type PrivacyPurposeCategories struct {
+	Purpose    PrivacyPurpose
+	Categories []PrivacyCategory
+}
+
+// Section returns the section of the given type.
+func (d *PrivacyDetails) Section(privacyType PrivacyType) (PrivacySection, bool) {
+	for _, section := range d.Sections {
+		if section.Type == privacyType {
+			return section, true
+		}
+	}
+
+	return PrivacySection{}, false
+}
+
+// TracksUser reports whether the application declares data used to track the user.
+func (d *PrivacyDetails) TracksUser() bool {
+	_, ok := d.Section(PrivacyTypeUsedToTrackYou)
+
+	return ok
+}
@@ -0,0 +1,24 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/entity"
+)
+
+func TestPrivacyDetails_TracksUser(t *testing.T) {
+	t.Parallel()
+
+	details := &entity.PrivacyDetails{Sections: []entity.PrivacySection{{Type: entity.PrivacyTypeNotCollected}}}
+	if details.TracksUser() {
+		t.Error("Expected an app without tracking data not to track the user")
+	}
+
+	details.Sections = append(details.Sections, entity.PrivacySection{
+		Type:       entity.PrivacyTypeUsedToTrackYou,
+		Categories: []entity.PrivacyCategory{entity.PrivacyCategoryIdentifiers},
+	})
+	if !details.TracksUser() {
+		t.Error("Expected an app with tracking data to track the user")
+	}
+}
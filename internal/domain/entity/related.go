@@ -0,0 +1,20 @@
+package entity
+
+// RelatedSection identifies the section of an application page a related application is listed in.
+type RelatedSection string
+
+const (
+	RelatedSectionMoreByDeveloper  RelatedSection = "more_by_developer"
+	RelatedSectionYouMightAlsoLike RelatedSection = "you_might_also_like"
+)
+
+// String returns the string representation of the related section.
+func (s RelatedSection) String() string {
+	return string(s)
+}
+
+// RelatedApplications represents the applications listed in one section of an application page.
+type RelatedApplications struct {
+	Section      RelatedSection
+	Applications []*Application
+}
@@ -10,10 +10,12 @@ import (
 
 // ApplicationRepository defines the interface for application data access.
 type ApplicationRepository interface {
-	// FindByAdamID finds applications by their Adam IDs
+	// FindByAdamID finds applications by their Adam IDs, in the order of adamIDs.
+	// IDs that are not found are omitted.
 	FindByAdamID(ctx context.Context, adamIDs []string) ([]*entity.Application, error)
 
-	// FindByBundleID finds applications by their Bundle IDs
+	// FindByBundleID finds applications by their Bundle IDs, in the order of bundleIDs.
+	// IDs that are not found are omitted.
 	FindByBundleID(ctx context.Context, bundleIDs []string) ([]*entity.Application, error)
 
 	// GetFullInfo retrieves detailed information about an application
@@ -24,4 +26,16 @@ type ApplicationRepository interface {
 
 	// GetOverallRating retrieves overall rating information
 	GetOverallRating(ctx context.Context, adamID string) (*entity.Rating, error)
+
+	// GetDeveloper retrieves a developer with the Adam IDs of up to limit of their applications
+	GetDeveloper(ctx context.Context, artistID string, limit int) (*entity.Developer, error)
+
+	// GetPrivacyDetails retrieves the App Privacy label of an application
+	GetPrivacyDetails(ctx context.Context, adamID string) (*entity.PrivacyDetails, error)
+
+	// GetRelated retrieves the sections of applications related to an application
+	GetRelated(ctx context.Context, adamID string) ([]entity.RelatedApplications, error)
+
+	// GetInAppPurchases retrieves the in-app purchases listed for an application in the storefront
+	GetInAppPurchases(ctx context.Context, adamID string) ([]entity.InAppPurchase, error)
 }
@@ -16,13 +16,15 @@ type ChartRepository interface {
 	// kidPrefix: optional age band filter
 	// from: starting position (1-based)
 	// limit: number of results to return
+	// Positions whose application info could not be fetched are reported
+	// in the chart errors instead of failing the whole request.
 	GetTop200(
 		ctx context.Context,
 		genreID string,
 		chartType entity.ChartType,
 		kidPrefix string,
 		from, limit int,
-	) ([]*entity.ChartItem, error)
+	) (*entity.Chart, error)
 
 	// GetTop1500 retrieves up to 1500 applications for a genre and chart type
 	// genreID: the genre identifier
@@ -35,4 +37,8 @@ type ChartRepository interface {
 		chartType entity.ChartType,
 		page, pageSize int,
 	) ([]*entity.ChartItem, error)
+
+	// GetGenres retrieves the App Store genre tree with names in the request language.
+	// Parents are listed before their subgenres.
+	GetGenres(ctx context.Context) ([]entity.GenreNode, error)
 }
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/entity"
+)
+
+//go:generate mockgen -source=chart_store.go -destination=mocks/mock_chart_store.go -package=mocks
+
+// ChartStore defines the interface for persisting chart snapshots and querying rank history.
+type ChartStore interface {
+	// SaveSnapshot stores the items of a chart captured at capturedAt.
+	// Saving the same chart and capture time again replaces the earlier snapshot.
+	SaveSnapshot(ctx context.Context, key entity.ChartKey, capturedAt time.Time, items []*entity.ChartItem) error
+
+	// RankHistory returns the positions of an application in a chart captured
+	// within [from, to], oldest first. Zero times leave the range open.
+	RankHistory(ctx context.Context, key entity.ChartKey, adamID string, from, to time.Time) ([]entity.RankPoint, error)
+
+	// BestRank returns the highest position an application reached in a chart;
+	// the earliest capture wins a tie. It returns ErrNoRankHistory if the application
+	// was never captured in the chart.
+	BestRank(ctx context.Context, key entity.ChartKey, adamID string) (*entity.RankPoint, error)
+
+	// DaysInTop returns the number of UTC calendar days on which an application was
+	// captured at position topN or better.
+	DaysInTop(ctx context.Context, key entity.ChartKey, adamID string, topN int) (int, error)
+}
@@ -0,0 +1,27 @@
+package repository
+
+import "errors"
+
+var (
+	// ErrApplicationNotFound is returned when an application does not exist or is not sold in the storefront.
+	ErrApplicationNotFound = errors.New("application not found")
+
+	// ErrDeveloperNotFound is returned when a developer has no applications in the storefront.
+	ErrDeveloperNotFound = errors.New("developer not found")
+
+	// ErrNoRankHistory is returned when an application was never captured in a tracked chart.
+	ErrNoRankHistory = errors.New("no rank history")
+
+	// ErrNotAuthenticated is returned when a request needs a valid session, e.g. after the password token expired.
+	ErrNotAuthenticated = errors.New("not authenticated")
+
+	// ErrInvalidCredentials is returned when Apple rejects the Apple ID or password.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+
+	// ErrTemporarilyUnavailable is returned when the App Store cannot serve a request
+	// for now; the same request may succeed later.
+	ErrTemporarilyUnavailable = errors.New("temporarily unavailable")
+
+	// ErrPurchaseFailed is returned when Apple refuses a purchase or download.
+	ErrPurchaseFailed = errors.New("purchase failed")
+)
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/valueobject"
+)
+
+// languageKey is the context key of the requested metadata language.
+type languageKey struct{}
+
+// ContextWithLanguage returns a context that asks repositories for metadata in lang.
+// It overrides the language a repository was configured with for a single call.
+func ContextWithLanguage(ctx context.Context, lang *valueobject.Language) context.Context {
+	return context.WithValue(ctx, languageKey{}, lang)
+}
+
+// LanguageFromContext returns the language requested with ContextWithLanguage, if any.
+func LanguageFromContext(ctx context.Context) (*valueobject.Language, bool) {
+	lang, ok := ctx.Value(languageKey{}).(*valueobject.Language)
+
+	return lang, ok && lang != nil
+}
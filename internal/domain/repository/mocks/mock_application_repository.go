@@ -71,6 +71,21 @@ func (mr *MockApplicationRepositoryMockRecorder) FindByBundleID(ctx, bundleIDs a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByBundleID", reflect.TypeOf((*MockApplicationRepository)(nil).FindByBundleID), ctx, bundleIDs)
 }
 
+// GetDeveloper mocks base method.
+func (m *MockApplicationRepository) GetDeveloper(ctx context.Context, artistID string, limit int) (*entity.Developer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeveloper", ctx, artistID, limit)
+	ret0, _ := ret[0].(*entity.Developer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeveloper indicates an expected call of GetDeveloper.
+func (mr *MockApplicationRepositoryMockRecorder) GetDeveloper(ctx, artistID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeveloper", reflect.TypeOf((*MockApplicationRepository)(nil).GetDeveloper), ctx, artistID, limit)
+}
+
 // GetFullInfo mocks base method.
 func (m *MockApplicationRepository) GetFullInfo(ctx context.Context, adamID string) (*entity.Application, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +101,21 @@ func (mr *MockApplicationRepositoryMockRecorder) GetFullInfo(ctx, adamID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFullInfo", reflect.TypeOf((*MockApplicationRepository)(nil).GetFullInfo), ctx, adamID)
 }
 
+// GetInAppPurchases mocks base method.
+func (m *MockApplicationRepository) GetInAppPurchases(ctx context.Context, adamID string) ([]entity.InAppPurchase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInAppPurchases", ctx, adamID)
+	ret0, _ := ret[0].([]entity.InAppPurchase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInAppPurchases indicates an expected call of GetInAppPurchases.
+func (mr *MockApplicationRepositoryMockRecorder) GetInAppPurchases(ctx, adamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInAppPurchases", reflect.TypeOf((*MockApplicationRepository)(nil).GetInAppPurchases), ctx, adamID)
+}
+
 // GetOverallRating mocks base method.
 func (m *MockApplicationRepository) GetOverallRating(ctx context.Context, adamID string) (*entity.Rating, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +131,21 @@ func (mr *MockApplicationRepositoryMockRecorder) GetOverallRating(ctx, adamID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverallRating", reflect.TypeOf((*MockApplicationRepository)(nil).GetOverallRating), ctx, adamID)
 }
 
+// GetPrivacyDetails mocks base method.
+func (m *MockApplicationRepository) GetPrivacyDetails(ctx context.Context, adamID string) (*entity.PrivacyDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrivacyDetails", ctx, adamID)
+	ret0, _ := ret[0].(*entity.PrivacyDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrivacyDetails indicates an expected call of GetPrivacyDetails.
+func (mr *MockApplicationRepositoryMockRecorder) GetPrivacyDetails(ctx, adamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivacyDetails", reflect.TypeOf((*MockApplicationRepository)(nil).GetPrivacyDetails), ctx, adamID)
+}
+
 // GetRating mocks base method.
 func (m *MockApplicationRepository) GetRating(ctx context.Context, adamID string) (*entity.Rating, error) {
 	m.ctrl.T.Helper()
@@ -115,3 +160,18 @@ func (mr *MockApplicationRepositoryMockRecorder) GetRating(ctx, adamID any) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRating", reflect.TypeOf((*MockApplicationRepository)(nil).GetRating), ctx, adamID)
 }
+
+// GetRelated mocks base method.
+func (m *MockApplicationRepository) GetRelated(ctx context.Context, adamID string) ([]entity.RelatedApplications, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelated", ctx, adamID)
+	ret0, _ := ret[0].([]entity.RelatedApplications)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelated indicates an expected call of GetRelated.
+func (mr *MockApplicationRepositoryMockRecorder) GetRelated(ctx, adamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelated", reflect.TypeOf((*MockApplicationRepository)(nil).GetRelated), ctx, adamID)
+}
@@ -41,6 +41,21 @@ func (m *MockChartRepository) EXPECT() *MockChartRepositoryMockRecorder {
 	return m.recorder
 }
 
+// GetGenres mocks base method.
+func (m *MockChartRepository) GetGenres(ctx context.Context) ([]entity.GenreNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGenres", ctx)
+	ret0, _ := ret[0].([]entity.GenreNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGenres indicates an expected call of GetGenres.
+func (mr *MockChartRepositoryMockRecorder) GetGenres(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGenres", reflect.TypeOf((*MockChartRepository)(nil).GetGenres), ctx)
+}
+
 // GetTop1500 mocks base method.
 func (m *MockChartRepository) GetTop1500(ctx context.Context, genreID string, chartType entity.ChartType, page, pageSize int) ([]*entity.ChartItem, error) {
 	m.ctrl.T.Helper()
@@ -57,10 +72,10 @@ func (mr *MockChartRepositoryMockRecorder) GetTop1500(ctx, genreID, chartType, p
 }
 
 // GetTop200 mocks base method.
-func (m *MockChartRepository) GetTop200(ctx context.Context, genreID string, chartType entity.ChartType, kidPrefix string, from, limit int) ([]*entity.ChartItem, error) {
+func (m *MockChartRepository) GetTop200(ctx context.Context, genreID string, chartType entity.ChartType, kidPrefix string, from, limit int) (*entity.Chart, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetTop200", ctx, genreID, chartType, kidPrefix, from, limit)
-	ret0, _ := ret[0].([]*entity.ChartItem)
+	ret0, _ := ret[0].(*entity.Chart)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
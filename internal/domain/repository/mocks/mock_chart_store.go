@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: chart_store.go
+//
+// Generated by this command:
+//
+//	mockgen -source=chart_store.go -destination=mocks/mock_chart_store.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/truewebber/goitunes/v2/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChartStore is a mock of ChartStore interface.
+type MockChartStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockChartStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockChartStoreMockRecorder is the mock recorder for MockChartStore.
+type MockChartStoreMockRecorder struct {
+	mock *MockChartStore
+}
+
+// NewMockChartStore creates a new mock instance.
+func NewMockChartStore(ctrl *gomock.Controller) *MockChartStore {
+	mock := &MockChartStore{ctrl: ctrl}
+	mock.recorder = &MockChartStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChartStore) EXPECT() *MockChartStoreMockRecorder {
+	return m.recorder
+}
+
+// BestRank mocks base method.
+func (m *MockChartStore) BestRank(ctx context.Context, key entity.ChartKey, adamID string) (*entity.RankPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BestRank", ctx, key, adamID)
+	ret0, _ := ret[0].(*entity.RankPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BestRank indicates an expected call of BestRank.
+func (mr *MockChartStoreMockRecorder) BestRank(ctx, key, adamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BestRank", reflect.TypeOf((*MockChartStore)(nil).BestRank), ctx, key, adamID)
+}
+
+// DaysInTop mocks base method.
+func (m *MockChartStore) DaysInTop(ctx context.Context, key entity.ChartKey, adamID string, topN int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DaysInTop", ctx, key, adamID, topN)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DaysInTop indicates an expected call of DaysInTop.
+func (mr *MockChartStoreMockRecorder) DaysInTop(ctx, key, adamID, topN any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DaysInTop", reflect.TypeOf((*MockChartStore)(nil).DaysInTop), ctx, key, adamID, topN)
+}
+
+// RankHistory mocks base method.
+func (m *MockChartStore) RankHistory(ctx context.Context, key entity.ChartKey, adamID string, from, to time.Time) ([]entity.RankPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RankHistory", ctx, key, adamID, from, to)
+	ret0, _ := ret[0].([]entity.RankPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RankHistory indicates an expected call of RankHistory.
+func (mr *MockChartStoreMockRecorder) RankHistory(ctx, key, adamID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RankHistory", reflect.TypeOf((*MockChartStore)(nil).RankHistory), ctx, key, adamID, from, to)
+}
+
+// SaveSnapshot mocks base method.
+func (m *MockChartStore) SaveSnapshot(ctx context.Context, key entity.ChartKey, capturedAt time.Time, items []*entity.ChartItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSnapshot", ctx, key, capturedAt, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSnapshot indicates an expected call of SaveSnapshot.
+func (mr *MockChartStoreMockRecorder) SaveSnapshot(ctx, key, capturedAt, items any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSnapshot", reflect.TypeOf((*MockChartStore)(nil).SaveSnapshot), ctx, key, capturedAt, items)
+}
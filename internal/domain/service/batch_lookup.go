@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/entity"
+)
+
+const (
+	// DefaultLookupBatchSize is the default number of identifiers requested in one lookup call.
+	DefaultLookupBatchSize = 50
+
+	// DefaultLookupConcurrency is the default number of lookup batches requested concurrently.
+	DefaultLookupConcurrency = 4
+)
+
+// BatchLookup looks up applications in batches that are requested concurrently.
+// Application lookups and lookups of chart entries missing from a chart response share it.
+type BatchLookup struct {
+	batchSize   int
+	concurrency int
+}
+
+// NewBatchLookup creates a BatchLookup with the default batch size and concurrency.
+func NewBatchLookup() *BatchLookup {
+	return &BatchLookup{
+		batchSize:   DefaultLookupBatchSize,
+		concurrency: DefaultLookupConcurrency,
+	}
+}
+
+// SetBatchSize sets the number of identifiers requested in one lookup call.
+// Non-positive values reset it to DefaultLookupBatchSize.
+func (l *BatchLookup) SetBatchSize(size int) *BatchLookup {
+	if size <= 0 {
+		size = DefaultLookupBatchSize
+	}
+
+	l.batchSize = size
+
+	return l
+}
+
+// SetConcurrency sets the number of lookup batches requested concurrently.
+// Non-positive values reset it to DefaultLookupConcurrency.
+func (l *BatchLookup) SetConcurrency(workers int) *BatchLookup {
+	if workers <= 0 {
+		workers = DefaultLookupConcurrency
+	}
+
+	l.concurrency = workers
+
+	return l
+}
+
+// Find looks up ids with find and returns the applications by key and the errors
+// of failed batches by identifier. A failed batch does not fail the lookup;
+// only context cancellation aborts all batches.
+func (l *BatchLookup) Find(
+	ctx context.Context,
+	ids []string,
+	find func(ctx context.Context, ids []string) ([]*entity.Application, error),
+	key func(app *entity.Application) string,
+) (map[string]*entity.Application, map[string]error, error) {
+	var (
+		mu           sync.Mutex
+		found        = make(map[string]*entity.Application, len(ids))
+		lookupErrors = make(map[string]error)
+	)
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(l.concurrency)
+
+	for batch := range slices.Chunk(ids, l.batchSize) {
+		group.Go(func() error {
+			apps, err := find(groupCtx, batch)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				if ctxErr := groupCtx.Err(); ctxErr != nil {
+					return ctxErr
+				}
+
+				for _, id := range batch {
+					lookupErrors[id] = err
+				}
+
+				return nil
+			}
+
+			for _, app := range apps {
+				found[key(app)] = app
+			}
+
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, nil, fmt.Errorf("failed to find apps: %w", err)
+	}
+
+	return found, lookupErrors, nil
+}
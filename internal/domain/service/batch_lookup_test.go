@@ -0,0 +1,76 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/entity"
+	"github.com/truewebber/goitunes/v2/internal/domain/service"
+)
+
+var errBatchFailed = errors.New("batch failed")
+
+func TestBatchLookup_Find(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu      sync.Mutex
+		batches [][]string
+	)
+
+	find := func(_ context.Context, ids []string) ([]*entity.Application, error) {
+		mu.Lock()
+		batches = append(batches, ids)
+		mu.Unlock()
+
+		if slices.Contains(ids, "3") {
+			return nil, errBatchFailed
+		}
+
+		apps := make([]*entity.Application, 0, len(ids))
+		for _, id := range ids {
+			apps = append(apps, entity.NewApplication(id, "com.test."+id, "App "+id))
+		}
+
+		return apps, nil
+	}
+
+	lookup := service.NewBatchLookup().SetBatchSize(2).SetConcurrency(2)
+
+	found, lookupErrors, err := lookup.Find(
+		context.Background(), []string{"1", "2", "3", "4", "5"}, find, (*entity.Application).AdamID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(batches) != 3 {
+		t.Errorf("Expected 3 batches, got %v", batches)
+	}
+
+	if len(found) != 3 || found["5"] == nil || found["5"].BundleID() != "com.test.5" {
+		t.Errorf("Expected apps 1, 2 and 5, got %v", found)
+	}
+
+	if len(lookupErrors) != 2 || !errors.Is(lookupErrors["3"], errBatchFailed) || !errors.Is(lookupErrors["4"], errBatchFailed) {
+		t.Errorf("Expected the failed batch for 3 and 4, got %v", lookupErrors)
+	}
+}
+
+func TestBatchLookup_Find_Canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	find := func(ctx context.Context, _ []string) ([]*entity.Application, error) {
+		return nil, ctx.Err()
+	}
+
+	_, _, err := service.NewBatchLookup().Find(ctx, []string{"1"}, find, (*entity.Application).AdamID)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,70 @@
+package service
+
+// defaultMinorUnits is the number of decimal digits used by most currencies.
+const defaultMinorUnits = 2
+
+// currencyMinorUnits lists ISO 4217 currencies whose minor unit differs from the default.
+var currencyMinorUnits = map[string]int{
+	"BHD": 3,
+	"CLP": 0,
+	"IQD": 3,
+	"ISK": 0,
+	"JOD": 3,
+	"JPY": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"LYD": 3,
+	"OMR": 3,
+	"PYG": 0,
+	"TND": 3,
+	"UGX": 0,
+	"VND": 0,
+}
+
+// defaultExchangeRates holds approximate units of each currency per one US dollar.
+// The values are a snapshot for offline use and are not kept up to date.
+var defaultExchangeRates = map[string]float64{
+	"AED": 3.6725,
+	"AUD": 1.53,
+	"BRL": 5.40,
+	"CAD": 1.38,
+	"CHF": 0.80,
+	"CLP": 950,
+	"CNY": 7.12,
+	"COP": 3900,
+	"CZK": 20.8,
+	"DKK": 6.36,
+	"EGP": 48.5,
+	"EUR": 0.85,
+	"GBP": 0.74,
+	"HKD": 7.78,
+	"HUF": 333,
+	"IDR": 16500,
+	"ILS": 3.33,
+	"INR": 88.5,
+	"JPY": 150,
+	"KRW": 1400,
+	"KZT": 540,
+	"MXN": 18.4,
+	"MYR": 4.22,
+	"NGN": 1500,
+	"NOK": 10.0,
+	"NZD": 1.73,
+	"PEN": 3.50,
+	"PHP": 57.5,
+	"PKR": 281,
+	"PLN": 3.63,
+	"QAR": 3.64,
+	"RON": 4.35,
+	"RUB": 82,
+	"SAR": 3.75,
+	"SEK": 9.40,
+	"SGD": 1.29,
+	"THB": 32.5,
+	"TRY": 41.6,
+	"TWD": 30.5,
+	"TZS": 2450,
+	"USD": 1,
+	"VND": 26300,
+	"ZAR": 17.4,
+}
@@ -1,8 +1,11 @@
 package service
 
 import (
+	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
+	"unicode"
 )
 
 // CurrencyService provides currency-related operations.
@@ -28,15 +31,146 @@ func (s *CurrencyService) ExtractCurrency(price float64, formattedPrice string)
 	return currency
 }
 
-// FormatPrice formats a price with currency.
+// ResolveCurrency returns the ISO 4217 code of the storefront currency and falls
+// back to the label extracted from formattedPrice for storefronts without a known code.
+func (s *CurrencyService) ResolveCurrency(storeCurrency string, price float64, formattedPrice string) string {
+	if storeCurrency != "" {
+		return storeCurrency
+	}
+
+	return s.ExtractCurrency(price, formattedPrice)
+}
+
+// MinorUnits returns the number of decimal digits of an ISO 4217 currency.
+func (s *CurrencyService) MinorUnits(currency string) int {
+	if units, ok := currencyMinorUnits[strings.ToUpper(currency)]; ok {
+		return units
+	}
+
+	return defaultMinorUnits
+}
+
+// NormalizeCurrencyCode validates and upper-cases an ISO 4217 currency code.
+func (s *CurrencyService) NormalizeCurrencyCode(currency string) (string, error) {
+	code := strings.ToUpper(strings.TrimSpace(currency))
+
+	if len(code) != 3 {
+		return "", fmt.Errorf("%w: %q", ErrInvalidCurrency, currency)
+	}
+
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return "", fmt.Errorf("%w: %q", ErrInvalidCurrency, currency)
+		}
+	}
+
+	return code, nil
+}
+
+// ParsePrice parses a localised price string such as "$1,234.56", "1.234,56 €",
+// "¥1,200" or "CHF 1'234.50". The currency is used to tell decimal separators
+// from grouping separators when the string alone is ambiguous.
+// Strings without digits, like "Free" or "Get", parse as zero.
+func (s *CurrencyService) ParsePrice(formattedPrice, currency string) (float64, error) {
+	first := strings.IndexFunc(formattedPrice, unicode.IsDigit)
+	if first < 0 {
+		return 0, nil
+	}
+
+	last := strings.LastIndexFunc(formattedPrice, unicode.IsDigit)
+	number := formattedPrice[first : last+1]
+
+	negative := strings.Contains(formattedPrice[:first], "-")
+
+	var digits strings.Builder
+
+	separators := make([]int, 0)
+
+	for _, r := range number {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == '.' || r == ',':
+			separators = append(separators, digits.Len())
+			digits.WriteRune(r)
+		case r == '\'' || r == '’' || unicode.IsSpace(r):
+			// Always a grouping separator.
+		default:
+			return 0, fmt.Errorf("%w: %q", ErrInvalidPrice, formattedPrice)
+		}
+	}
+
+	normalized := s.normalizeSeparators(digits.String(), separators, currency)
+	if negative {
+		normalized = "-" + normalized
+	}
+
+	price, err := strconv.ParseFloat(normalized, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidPrice, formattedPrice)
+	}
+
+	return price, nil
+}
+
+// normalizeSeparators drops grouping separators and turns the decimal separator into a dot.
+// positions holds the byte offsets of '.' and ',' within number.
+func (s *CurrencyService) normalizeSeparators(number string, positions []int, currency string) string {
+	if len(positions) == 0 {
+		return number
+	}
+
+	lastPos := positions[len(positions)-1]
+	decimal := number[lastPos]
+	decimalPos := -1
+
+	switch {
+	case strings.Count(number, ".") > 0 && strings.Count(number, ",") > 0:
+		// Both separators present: the last one is the decimal separator.
+		decimalPos = lastPos
+	case len(positions) > 1:
+		// The same separator repeated can only be grouping.
+	default:
+		fraction := len(number) - lastPos - 1
+		units := s.MinorUnits(currency)
+
+		if units > 0 && (fraction == units || fraction != 3) {
+			decimalPos = lastPos
+		}
+	}
+
+	var normalized strings.Builder
+
+	for i := range len(number) {
+		switch {
+		case i == decimalPos:
+			normalized.WriteByte('.')
+		case number[i] == '.' || number[i] == ',':
+			if decimalPos >= 0 && number[i] == decimal && i != decimalPos {
+				// A second decimal separator makes the number ambiguous; keep it so parsing fails.
+				normalized.WriteByte(number[i])
+			}
+		default:
+			normalized.WriteByte(number[i])
+		}
+	}
+
+	return normalized.String()
+}
+
+// FormatPrice formats a price in the given currency, e.g. "9.99 USD" or "1200 JPY".
+// Zero prices are formatted as "Free".
 func (s *CurrencyService) FormatPrice(price float64, currency string) string {
 	if price == 0 {
 		return "Free"
 	}
 
+	amount := strconv.FormatFloat(price, 'f', s.MinorUnits(currency), 64)
+
+	currency = strings.TrimSpace(currency)
 	if currency == "" {
-		return "0"
+		return amount
 	}
 
-	return currency
+	return amount + " " + currency
 }
@@ -1,6 +1,7 @@
 package service_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/truewebber/goitunes/v2/internal/domain/service"
@@ -99,9 +100,15 @@ func TestCurrencyService_FormatPrice(t *testing.T) {
 		price    float64
 	}{
 		{name: "Free", price: 0, currency: "", expected: "Free"},
-		{name: "Free with currency", price: 0, currency: "$", expected: "Free"},
-		{name: "Paid with currency", price: 9.99, currency: "$", expected: "$"},
-		{name: "No currency", price: 9.99, currency: "", expected: "0"},
+		{name: "Free with currency", price: 0, currency: "USD", expected: "Free"},
+		{name: "USD", price: 9.99, currency: "USD", expected: "9.99 USD"},
+		{name: "EUR rounds to cents", price: 8.999, currency: "EUR", expected: "9.00 EUR"},
+		{name: "JPY has no minor unit", price: 1200, currency: "JPY", expected: "1200 JPY"},
+		{name: "KWD has three decimals", price: 1.25, currency: "KWD", expected: "1.250 KWD"},
+		{name: "Lower-case code", price: 100, currency: "krw", expected: "100 krw"},
+		{name: "Symbol", price: 9.99, currency: "$", expected: "9.99 $"},
+		{name: "No currency", price: 9.99, currency: "", expected: "9.99"},
+		{name: "Negative price", price: -9.99, currency: "USD", expected: "-9.99 USD"},
 	}
 
 	for _, tt := range tests {
@@ -116,42 +123,114 @@ func TestCurrencyService_FormatPrice(t *testing.T) {
 	}
 }
 
-func TestCurrencyService_FormatPrice_EdgeCases(t *testing.T) {
+func TestCurrencyService_ParsePrice(t *testing.T) {
 	t.Parallel()
 
 	currencyService := service.NewCurrencyService()
 
 	tests := []struct {
-		name     string
-		currency string
-		expected string
-		price    float64
+		name           string
+		formattedPrice string
+		currency       string
+		expected       float64
+		expectError    bool
 	}{
-		// Unicode currencies
-		{name: "With euro", price: 9.99, currency: "€", expected: "€"},
-		{name: "With ruble", price: 100, currency: "₽", expected: "₽"},
-		{name: "With won", price: 1000, currency: "₩", expected: "₩"},
-		{name: "With rupee", price: 99, currency: "₹", expected: "₹"},
-
-		// Edge prices
-		{name: "Negative price", price: -9.99, currency: "$", expected: "$"},
-		{name: "Very small price", price: 0.01, currency: "$", expected: "$"},
-		{name: "Very large price", price: 999999.99, currency: "$", expected: "$"},
-		{name: "Exactly zero", price: 0.0, currency: "$", expected: "Free"},
-
-		// Special currency strings
-		{name: "Long currency code", price: 9.99, currency: "USD", expected: "USD"},
-		{name: "Multiple symbols", price: 9.99, currency: "$€¥", expected: "$€¥"},
-		{name: "Currency with spaces", price: 9.99, currency: "$ ", expected: "$ "},
-		{name: "Unicode text currency", price: 9.99, currency: "долларов", expected: "долларов"},
-		{name: "Empty currency with paid", price: 9.99, currency: "", expected: "0"},
+		{name: "USD", formattedPrice: "$1,234.56", currency: "USD", expected: 1234.56},
+		{name: "EUR with dot grouping", formattedPrice: "1.234,56 €", currency: "EUR", expected: 1234.56},
+		{name: "EUR cents only", formattedPrice: "€8,99", currency: "EUR", expected: 8.99},
+		{name: "EUR grouping only", formattedPrice: "1.234 €", currency: "EUR", expected: 1234},
+		{name: "JPY grouping", formattedPrice: "¥1,200", currency: "JPY", expected: 1200},
+		{name: "KRW grouping", formattedPrice: "₩12,000", currency: "KRW", expected: 12000},
+		{name: "RUB with space grouping", formattedPrice: "1 299,00 ₽", currency: "RUB", expected: 1299},
+		{name: "Non-breaking space grouping", formattedPrice: "1\u00a0299,00\u00a0₽", currency: "RUB", expected: 1299},
+		{name: "CHF apostrophe grouping", formattedPrice: "CHF 1'234.50", currency: "CHF", expected: 1234.5},
+		{name: "IDR dot grouping", formattedPrice: "Rp 15.000", currency: "IDR", expected: 15000},
+		{name: "KWD three decimals", formattedPrice: "KWD 1.250", currency: "KWD", expected: 1.25},
+		{name: "Multiple grouping", formattedPrice: "$1,234,567", currency: "USD", expected: 1234567},
+		{name: "Currency code prefix", formattedPrice: "US$ 12.99", currency: "USD", expected: 12.99},
+		{name: "Negative", formattedPrice: "-$9.99", currency: "USD", expected: -9.99},
+		{name: "Free", formattedPrice: "Free", currency: "USD", expected: 0},
+		{name: "Get", formattedPrice: "Get", currency: "USD", expected: 0},
+		{name: "Empty", formattedPrice: "", currency: "USD", expected: 0},
+		{name: "Letters inside number", formattedPrice: "1a2", currency: "USD", expectError: true},
+		{name: "Repeated decimal separator", formattedPrice: "1,2,3.4,5", currency: "USD", expectError: true},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			result := currencyService.FormatPrice(tt.price, tt.currency)
+			result, err := currencyService.ParsePrice(tt.formattedPrice, tt.currency)
+
+			if tt.expectError {
+				if !errors.Is(err, service.ErrInvalidPrice) {
+					t.Errorf("Expected ErrInvalidPrice, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestCurrencyService_ResolveCurrency(t *testing.T) {
+	t.Parallel()
+
+	currencyService := service.NewCurrencyService()
+
+	if result := currencyService.ResolveCurrency("GBP", 0.99, "£0.99"); result != "GBP" {
+		t.Errorf("Expected GBP, got %q", result)
+	}
+
+	if result := currencyService.ResolveCurrency("", 0.99, "£0.99"); result != "£" {
+		t.Errorf("Expected fallback to £, got %q", result)
+	}
+}
+
+func TestCurrencyService_NormalizeCurrencyCode(t *testing.T) {
+	t.Parallel()
+
+	currencyService := service.NewCurrencyService()
+
+	tests := []struct {
+		input       string
+		expected    string
+		expectError bool
+	}{
+		{"usd", "USD", false},
+		{" EUR ", "EUR", false},
+		{"$", "", true},
+		{"US1", "", true},
+		{"EURO", "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := currencyService.NormalizeCurrencyCode(tt.input)
+
+			if tt.expectError {
+				if !errors.Is(err, service.ErrInvalidCurrency) {
+					t.Errorf("Expected ErrInvalidCurrency, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
 			if result != tt.expected {
 				t.Errorf("Expected %q, got %q", tt.expected, result)
 			}
@@ -0,0 +1,17 @@
+package service
+
+import "errors"
+
+var (
+	// ErrInvalidPrice is returned when a formatted price cannot be parsed.
+	ErrInvalidPrice = errors.New("invalid price")
+
+	// ErrInvalidCurrency is returned when a currency code is not a valid ISO 4217 code.
+	ErrInvalidCurrency = errors.New("invalid currency code")
+
+	// ErrInvalidExchangeRate is returned when an exchange rate is not positive.
+	ErrInvalidExchangeRate = errors.New("exchange rate must be positive")
+
+	// ErrExchangeRateNotFound is returned when no rate is known for a currency pair.
+	ErrExchangeRateNotFound = errors.New("exchange rate not found")
+)
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"fmt"
+)
+
+// ExchangeRateProvider supplies conversion rates between ISO 4217 currencies.
+type ExchangeRateProvider interface {
+	// Rate returns how many units of to equal one unit of from.
+	Rate(ctx context.Context, from, to string) (float64, error)
+}
+
+// StaticExchangeRates is an ExchangeRateProvider backed by a fixed table
+// of rates relative to a single base currency. It never performs I/O.
+type StaticExchangeRates struct {
+	rates    map[string]float64
+	base     string
+	currency *CurrencyService
+}
+
+// NewStaticExchangeRates creates a provider from units of each currency per one unit of base.
+func NewStaticExchangeRates(base string, rates map[string]float64) (*StaticExchangeRates, error) {
+	currency := NewCurrencyService()
+
+	baseCode, err := currency.NormalizeCurrencyCode(base)
+	if err != nil {
+		return nil, err
+	}
+
+	table := make(map[string]float64, len(rates)+1)
+
+	for code, rate := range rates {
+		normalized, err := currency.NormalizeCurrencyCode(code)
+		if err != nil {
+			return nil, err
+		}
+
+		if rate <= 0 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidExchangeRate, normalized)
+		}
+
+		table[normalized] = rate
+	}
+
+	table[baseCode] = 1
+
+	return &StaticExchangeRates{
+		rates:    table,
+		base:     baseCode,
+		currency: currency,
+	}, nil
+}
+
+// DefaultExchangeRates returns a provider with built-in approximate rates against USD.
+// The table is a convenience for offline use and goes stale; plug in a live
+// ExchangeRateProvider when accuracy matters.
+func DefaultExchangeRates() *StaticExchangeRates {
+	rates := make(map[string]float64, len(defaultExchangeRates))
+	for code, rate := range defaultExchangeRates {
+		rates[code] = rate
+	}
+
+	return &StaticExchangeRates{
+		rates:    rates,
+		base:     "USD",
+		currency: NewCurrencyService(),
+	}
+}
+
+// Base returns the base currency of the table.
+func (p *StaticExchangeRates) Base() string { return p.base }
+
+// Rate returns how many units of to equal one unit of from.
+func (p *StaticExchangeRates) Rate(_ context.Context, from, to string) (float64, error) {
+	fromCode, err := p.currency.NormalizeCurrencyCode(from)
+	if err != nil {
+		return 0, err
+	}
+
+	toCode, err := p.currency.NormalizeCurrencyCode(to)
+	if err != nil {
+		return 0, err
+	}
+
+	fromRate, ok := p.rates[fromCode]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s to %s", ErrExchangeRateNotFound, fromCode, toCode)
+	}
+
+	toRate, ok := p.rates[toCode]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s to %s", ErrExchangeRateNotFound, fromCode, toCode)
+	}
+
+	return toRate / fromRate, nil
+}
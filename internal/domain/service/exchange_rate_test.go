@@ -0,0 +1,108 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/service"
+)
+
+func TestStaticExchangeRates_Rate(t *testing.T) {
+	t.Parallel()
+
+	rates, err := service.NewStaticExchangeRates("usd", map[string]float64{
+		"EUR": 0.8,
+		"jpy": 150,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if rates.Base() != "USD" {
+		t.Errorf("Expected base USD, got %q", rates.Base())
+	}
+
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		expected float64
+	}{
+		{"Base to quote", "USD", "EUR", 0.8},
+		{"Quote to base", "EUR", "USD", 1.25},
+		{"Cross rate", "EUR", "JPY", 187.5},
+		{"Same currency", "JPY", "JPY", 1},
+		{"Lower-case codes", "eur", "usd", 1.25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rate, err := rates.Rate(context.Background(), tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if math.Abs(rate-tt.expected) > 1e-9 {
+				t.Errorf("Expected %v, got %v", tt.expected, rate)
+			}
+		})
+	}
+
+	if _, err := rates.Rate(context.Background(), "GBP", "USD"); !errors.Is(err, service.ErrExchangeRateNotFound) {
+		t.Errorf("Expected ErrExchangeRateNotFound, got %v", err)
+	}
+
+	if _, err := rates.Rate(context.Background(), "$", "USD"); !errors.Is(err, service.ErrInvalidCurrency) {
+		t.Errorf("Expected ErrInvalidCurrency, got %v", err)
+	}
+}
+
+func TestNewStaticExchangeRates_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		base          string
+		rates         map[string]float64
+		expectedError error
+	}{
+		{"Invalid base", "dollar", nil, service.ErrInvalidCurrency},
+		{"Invalid code", "USD", map[string]float64{"EU": 0.8}, service.ErrInvalidCurrency},
+		{"Zero rate", "USD", map[string]float64{"EUR": 0}, service.ErrInvalidExchangeRate},
+		{"Negative rate", "USD", map[string]float64{"EUR": -1}, service.ErrInvalidExchangeRate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := service.NewStaticExchangeRates(tt.base, tt.rates)
+			if !errors.Is(err, tt.expectedError) {
+				t.Errorf("Expected %v, got %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestDefaultExchangeRates(t *testing.T) {
+	t.Parallel()
+
+	rates := service.DefaultExchangeRates()
+
+	for _, code := range []string{"EUR", "GBP", "JPY", "RUB", "BRL"} {
+		rate, err := rates.Rate(context.Background(), code, "USD")
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", code, err)
+
+			continue
+		}
+
+		if rate <= 0 {
+			t.Errorf("%s: expected a positive rate, got %v", code, rate)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package valueobject
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ContentRatingSystemApple is the App Store's own age rating system.
+const ContentRatingSystemApple = "appsApple"
+
+// UnknownMinimumAge is the minimum age of ratings whose label has no age, e.g. "Not yet rated".
+const UnknownMinimumAge = -1
+
+// ContentRating is the age rating of an application in a rating system,
+// e.g. "12+" in the App Store system, with the content advisories behind it.
+type ContentRating struct {
+	system     string
+	label      string
+	advisories []string
+	rank       int
+	minimumAge int
+}
+
+// NewContentRating creates a new ContentRating. The minimum age is taken from
+// the first number of the label, e.g. 12 for "12+"; labels without a number
+// such as "Not yet rated" have UnknownMinimumAge.
+func NewContentRating(system, label string, rank int, advisories []string) (*ContentRating, error) {
+	label = strings.TrimSpace(label)
+	if label == "" {
+		return nil, ErrEmptyContentRating
+	}
+
+	if rank < 0 {
+		return nil, ErrInvalidContentRatingRank
+	}
+
+	return &ContentRating{
+		system:     strings.TrimSpace(system),
+		label:      label,
+		advisories: slices.Clone(advisories),
+		rank:       rank,
+		minimumAge: parseMinimumAge(label),
+	}, nil
+}
+
+// System returns the rating system, e.g. "appsApple".
+func (r *ContentRating) System() string { return r.system }
+
+// Label returns the rating as shown in the store, e.g. "12+".
+func (r *ContentRating) Label() string { return r.label }
+
+// Rank returns the position of the rating within its system; higher is more restrictive.
+func (r *ContentRating) Rank() int { return r.rank }
+
+// MinimumAge returns the youngest age the rating allows, or UnknownMinimumAge.
+func (r *ContentRating) MinimumAge() int { return r.minimumAge }
+
+// Advisories returns the content descriptions that led to the rating.
+func (r *ContentRating) Advisories() []string { return r.advisories }
+
+// SuitableFor reports whether the rating allows users of the given age.
+// Ratings without a known minimum age are suitable for no one.
+func (r *ContentRating) SuitableFor(age int) bool {
+	return r.minimumAge != UnknownMinimumAge && r.minimumAge <= age
+}
+
+// parseMinimumAge returns the first number in label, or UnknownMinimumAge if there is none.
+func parseMinimumAge(label string) int {
+	start := strings.IndexFunc(label, unicode.IsDigit)
+	if start < 0 {
+		return UnknownMinimumAge
+	}
+
+	end := strings.IndexFunc(label[start:], func(r rune) bool { return !unicode.IsDigit(r) })
+	if end < 0 {
+		end = len(label) - start
+	}
+
+	age, err := strconv.Atoi(label[start : start+end])
+	if err != nil {
+		return UnknownMinimumAge
+	}
+
+	return age
+}
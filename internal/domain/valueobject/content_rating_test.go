@@ -0,0 +1,84 @@
+package valueobject_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/valueobject"
+)
+
+func TestNewContentRating(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		label              string
+		rank               int
+		expectedErr        error
+		expectedMinimumAge int
+	}{
+		{"Apple rating", "12+", 3, nil, 12},
+		{"All ages", "4+", 1, nil, 4},
+		{"Rating with prefix", "Ages 17 and up", 4, nil, 17},
+		{"Not rated", "Not yet rated", 0, nil, valueobject.UnknownMinimumAge},
+		{"Empty label", " ", 1, valueobject.ErrEmptyContentRating, 0},
+		{"Negative rank", "4+", -1, valueobject.ErrInvalidContentRatingRank, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rating, err := valueobject.NewContentRating(valueobject.ContentRatingSystemApple, tt.label, tt.rank, nil)
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("Expected %v, got %v", tt.expectedErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if rating.MinimumAge() != tt.expectedMinimumAge {
+				t.Errorf("Expected minimum age %d, got %d", tt.expectedMinimumAge, rating.MinimumAge())
+			}
+
+			if rating.Rank() != tt.rank {
+				t.Errorf("Expected rank %d, got %d", tt.rank, rating.Rank())
+			}
+		})
+	}
+}
+
+func TestContentRating_SuitableFor(t *testing.T) {
+	t.Parallel()
+
+	rating, err := valueobject.NewContentRating(valueobject.ContentRatingSystemApple, "9+", 2, []string{"Mild Violence"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if rating.SuitableFor(8) {
+		t.Error("Expected 9+ to be unsuitable for age 8")
+	}
+
+	if !rating.SuitableFor(9) {
+		t.Error("Expected 9+ to be suitable for age 9")
+	}
+
+	if len(rating.Advisories()) != 1 || rating.Advisories()[0] != "Mild Violence" {
+		t.Errorf("Unexpected advisories: %v", rating.Advisories())
+	}
+
+	unrated, err := valueobject.NewContentRating(valueobject.ContentRatingSystemApple, "Not yet rated", 0, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if unrated.SuitableFor(17) {
+		t.Error("Expected a rating without an age to be unsuitable for every age")
+	}
+}
@@ -1,11 +1,14 @@
 package valueobject
 
+import "sync/atomic"
+
 // Credentials represents authentication credentials for App Store.
 type Credentials struct {
 	appleID       string
-	passwordToken string // X-Token
-	dsid          string // Directory Services ID
-	kbsync        string // Certificate for buying
+	passwordToken string       // X-Token
+	dsid          string       // Directory Services ID
+	kbsync        string       // Certificate for buying
+	pod           atomic.Int64 // Host prefix of the authenticated session, zero until discovered
 }
 
 // NewCredentials creates a new Credentials value object.
@@ -52,6 +55,9 @@ func (c *Credentials) DSID() string { return c.dsid }
 // Kbsync returns the kbsync certificate.
 func (c *Credentials) Kbsync() string { return c.kbsync }
 
+// Pod returns the host prefix of the authenticated session, or zero if unknown.
+func (c *Credentials) Pod() int { return int(c.pod.Load()) }
+
 // SetPasswordToken sets the password token (after authentication).
 func (c *Credentials) SetPasswordToken(token string) *Credentials {
 	c.passwordToken = token
@@ -73,6 +79,14 @@ func (c *Credentials) SetKbsync(kbsync string) *Credentials {
 	return c
 }
 
+// SetPod sets the host prefix of the authenticated session (after authentication,
+// or when Apple moves the session to another pod). It is safe for concurrent use.
+func (c *Credentials) SetPod(pod int) *Credentials {
+	c.pod.Store(int64(pod))
+
+	return c
+}
+
 // IsAuthenticated returns true if credentials have authentication tokens.
 func (c *Credentials) IsAuthenticated() bool {
 	return c.passwordToken != "" && c.dsid != ""
@@ -188,6 +188,25 @@ func TestCredentials_SetMethods(t *testing.T) {
 		}
 	})
 
+	t.Run("SetPod", func(t *testing.T) {
+		t.Parallel()
+
+		creds, err := valueobject.NewCredentials(testAppleID)
+		if err != nil {
+			t.Fatalf("Failed to create credentials: %v", err)
+		}
+
+		if creds.Pod() != 0 {
+			t.Errorf("Expected no pod before authentication, got %d", creds.Pod())
+		}
+
+		result := creds.SetPod(71)
+
+		if result.Pod() != 71 {
+			t.Errorf("Expected pod 71, got %d", result.Pod())
+		}
+	})
+
 	t.Run("Set empty values", func(t *testing.T) {
 		t.Parallel()
 
@@ -0,0 +1,115 @@
+package valueobject
+
+import (
+	"slices"
+	"strings"
+)
+
+// DeviceFamily represents a family of devices an application runs on.
+type DeviceFamily string
+
+// Device families as the store reports them.
+const (
+	DeviceFamilyIPhone DeviceFamily = "iphone"
+	DeviceFamilyIPad   DeviceFamily = "ipad"
+	DeviceFamilyIPod   DeviceFamily = "ipod"
+	DeviceFamilyMac    DeviceFamily = "mac"
+	DeviceFamilyTV     DeviceFamily = "tvos"
+	DeviceFamilyWatch  DeviceFamily = "watch"
+	DeviceFamilyVision DeviceFamily = "realityDevice"
+)
+
+// deviceFamilies maps device family values of store responses to device families.
+var deviceFamilies = map[string]DeviceFamily{
+	"iphone":        DeviceFamilyIPhone,
+	"ipad":          DeviceFamilyIPad,
+	"ipod":          DeviceFamilyIPod,
+	"mac":           DeviceFamilyMac,
+	"tvos":          DeviceFamilyTV,
+	"appletv":       DeviceFamilyTV,
+	"watch":         DeviceFamilyWatch,
+	"realityDevice": DeviceFamilyVision,
+	"vision":        DeviceFamilyVision,
+}
+
+// Platform returns the platform applications of the device family are made for.
+// iPod touch runs iPhone apps.
+func (f DeviceFamily) Platform() *Platform {
+	for name, family := range platformDeviceFamilies {
+		if family == f {
+			return &Platform{name: name}
+		}
+	}
+
+	return DefaultPlatform()
+}
+
+// DeviceFamilies is the set of device families an application supports.
+type DeviceFamilies struct {
+	families []DeviceFamily
+}
+
+// NewDeviceFamilies parses device family values as the store reports them,
+// e.g. ["iphone", "ipad", "mac"]. Unknown values are ignored.
+func NewDeviceFamilies(values []string) DeviceFamilies {
+	families := make([]DeviceFamily, 0, len(values))
+
+	for _, value := range values {
+		if family, ok := deviceFamilies[value]; ok && !slices.Contains(families, family) {
+			families = append(families, family)
+		}
+	}
+
+	slices.Sort(families)
+
+	return DeviceFamilies{families: families}
+}
+
+// List returns the device families in alphabetical order.
+func (d DeviceFamilies) List() []DeviceFamily { return slices.Clone(d.families) }
+
+// Platforms returns the platforms the application runs on, ordered by platform name.
+func (d DeviceFamilies) Platforms() []*Platform {
+	platforms := make([]*Platform, 0, len(d.families))
+
+	for _, family := range d.families {
+		platform := family.Platform()
+		if !slices.ContainsFunc(platforms, platform.Equals) {
+			platforms = append(platforms, platform)
+		}
+	}
+
+	slices.SortFunc(platforms, func(a, b *Platform) int { return strings.Compare(a.name, b.name) })
+
+	return platforms
+}
+
+// Len returns the number of device families.
+func (d DeviceFamilies) Len() int { return len(d.families) }
+
+// Has reports whether the set contains family.
+func (d DeviceFamilies) Has(family DeviceFamily) bool { return slices.Contains(d.families, family) }
+
+// Supports reports whether applications of the set run on platform.
+func (d DeviceFamilies) Supports(platform *Platform) bool { return d.Has(platform.DeviceFamily()) }
+
+// SupportsIPhone reports whether the application runs on iPhone.
+func (d DeviceFamilies) SupportsIPhone() bool { return d.Has(DeviceFamilyIPhone) }
+
+// SupportsIPad reports whether the application runs on iPad.
+func (d DeviceFamilies) SupportsIPad() bool { return d.Has(DeviceFamilyIPad) }
+
+// SupportsMac reports whether the application runs on Mac.
+func (d DeviceFamilies) SupportsMac() bool { return d.Has(DeviceFamilyMac) }
+
+// SupportsTV reports whether the application runs on Apple TV.
+func (d DeviceFamilies) SupportsTV() bool { return d.Has(DeviceFamilyTV) }
+
+// SupportsWatch reports whether the application runs on Apple Watch.
+func (d DeviceFamilies) SupportsWatch() bool { return d.Has(DeviceFamilyWatch) }
+
+// SupportsVision reports whether the application runs on Apple Vision Pro.
+func (d DeviceFamilies) SupportsVision() bool { return d.Has(DeviceFamilyVision) }
+
+// IsUniversal reports whether the application runs on both iPhone and iPad.
+func (d DeviceFamilies) IsUniversal() bool { return d.SupportsIPhone() && d.SupportsIPad() }
@@ -29,5 +29,26 @@ var (
 
 	// ErrInvalidHostPrefix is returned when host prefix is not positive.
 	ErrInvalidHostPrefix = errors.New("hostPrefix must be positive")
+
+	// ErrEmptyLanguage is returned when language tag is empty.
+	ErrEmptyLanguage = errors.New("language cannot be empty")
+
+	// ErrInvalidLanguage is returned when a language tag is not of the form "ll" or "ll-RR".
+	ErrInvalidLanguage = errors.New("invalid language tag")
+
+	// ErrInvalidPlatform is returned when a platform name is not known.
+	ErrInvalidPlatform = errors.New("invalid platform")
+
+	// ErrEmptyContentRating is returned when a content rating label is empty.
+	ErrEmptyContentRating = errors.New("content rating label cannot be empty")
+
+	// ErrInvalidContentRatingRank is returned when a content rating rank is negative.
+	ErrInvalidContentRatingRank = errors.New("content rating rank cannot be negative")
+
+	// ErrInvalidAlpha3 is returned when a country code is not a three-letter ISO 3166-1 code.
+	ErrInvalidAlpha3 = errors.New("alpha3 must be a three-letter country code")
+
+	// ErrInvalidCurrency is returned when a currency is not a three-letter ISO 4217 code.
+	ErrInvalidCurrency = errors.New("currency must be a three-letter currency code")
 )
 
@@ -0,0 +1,71 @@
+package valueobject
+
+import (
+	"strings"
+)
+
+// Language identifies the language of localised App Store metadata.
+type Language struct {
+	language string // ISO 639 code, lower case
+	region   string // ISO 3166-1 code, upper case; empty for language-only tags
+}
+
+// NewLanguage creates a Language from a tag such as "ja", "de-DE" or "en_us".
+func NewLanguage(tag string) (*Language, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return nil, ErrEmptyLanguage
+	}
+
+	language, region, hasRegion := strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-")
+
+	if !isLetters(language) || len(language) < 2 || len(language) > 3 {
+		return nil, ErrInvalidLanguage
+	}
+
+	if hasRegion && (!isLetters(region) || len(region) != 2) {
+		return nil, ErrInvalidLanguage
+	}
+
+	return &Language{
+		language: strings.ToLower(language),
+		region:   strings.ToUpper(region),
+	}, nil
+}
+
+// Tag returns the BCP 47 tag, e.g. "ja-JP".
+func (l *Language) Tag() string {
+	if l.region == "" {
+		return l.language
+	}
+
+	return l.language + "-" + l.region
+}
+
+// Locale returns the tag in the form used by App Store query parameters, e.g. "ja_jp".
+func (l *Language) Locale() string {
+	return strings.ToLower(strings.ReplaceAll(l.Tag(), "-", "_"))
+}
+
+// Base returns the language code without region, e.g. "ja".
+func (l *Language) Base() string { return l.language }
+
+// Equals checks if two languages are equal.
+func (l *Language) Equals(other *Language) bool {
+	if other == nil {
+		return false
+	}
+
+	return l.language == other.language && l.region == other.region
+}
+
+// isLetters reports whether s consists of ASCII letters only.
+func isLetters(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,85 @@
+package valueobject_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/valueobject"
+)
+
+func TestNewLanguage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		tag            string
+		expectedErr    error
+		expectedTag    string
+		expectedLocale string
+		expectedBase   string
+	}{
+		{"Language only", "ja", nil, "ja", "ja", "ja"},
+		{"BCP 47 tag", "de-DE", nil, "de-DE", "de_de", "de"},
+		{"Apple locale", "en_us", nil, "en-US", "en_us", "en"},
+		{"Mixed case with whitespace", " PT-br ", nil, "pt-BR", "pt_br", "pt"},
+		{"Three letter language", "fil-PH", nil, "fil-PH", "fil_ph", "fil"},
+		{"Empty", "", valueobject.ErrEmptyLanguage, "", "", ""},
+		{"Whitespace only", "  ", valueobject.ErrEmptyLanguage, "", "", ""},
+		{"Single letter", "e", valueobject.ErrInvalidLanguage, "", "", ""},
+		{"Digits", "e1-US", valueobject.ErrInvalidLanguage, "", "", ""},
+		{"Long region", "en-USA", valueobject.ErrInvalidLanguage, "", "", ""},
+		{"Script subtag", "zh-Hant-TW", valueobject.ErrInvalidLanguage, "", "", ""},
+		{"Trailing separator", "en-", valueobject.ErrInvalidLanguage, "", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			lang, err := valueobject.NewLanguage(tt.tag)
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("Expected %v, got %v", tt.expectedErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if lang.Tag() != tt.expectedTag {
+				t.Errorf("Expected tag %s, got %s", tt.expectedTag, lang.Tag())
+			}
+
+			if lang.Locale() != tt.expectedLocale {
+				t.Errorf("Expected locale %s, got %s", tt.expectedLocale, lang.Locale())
+			}
+
+			if lang.Base() != tt.expectedBase {
+				t.Errorf("Expected base %s, got %s", tt.expectedBase, lang.Base())
+			}
+		})
+	}
+}
+
+func TestLanguage_Equals(t *testing.T) {
+	t.Parallel()
+
+	a, _ := valueobject.NewLanguage("en_gb")
+	b, _ := valueobject.NewLanguage("en-GB")
+	c, _ := valueobject.NewLanguage("en")
+
+	if !a.Equals(b) {
+		t.Error("Expected en_gb to equal en-GB")
+	}
+
+	if a.Equals(c) {
+		t.Error("Expected en-GB not to equal en")
+	}
+
+	if a.Equals(nil) {
+		t.Error("Expected language not to equal nil")
+	}
+}
@@ -0,0 +1,86 @@
+package valueobject
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Platform names.
+const (
+	PlatformNameIPhone  = "iphone"
+	PlatformNameIPad    = "ipad"
+	PlatformNameMac     = "mac"
+	PlatformNameAppleTV = "appletv"
+	PlatformNameWatch   = "watch"
+	PlatformNameVision  = "vision"
+)
+
+// platformAliases maps accepted platform names and OS names to platform names.
+var platformAliases = map[string]string{
+	PlatformNameIPhone:  PlatformNameIPhone,
+	"ios":               PlatformNameIPhone,
+	PlatformNameIPad:    PlatformNameIPad,
+	"ipados":            PlatformNameIPad,
+	PlatformNameMac:     PlatformNameMac,
+	"macos":             PlatformNameMac,
+	"osx":               PlatformNameMac,
+	PlatformNameAppleTV: PlatformNameAppleTV,
+	"tv":                PlatformNameAppleTV,
+	"tvos":              PlatformNameAppleTV,
+	PlatformNameWatch:   PlatformNameWatch,
+	"watchos":           PlatformNameWatch,
+	PlatformNameVision:  PlatformNameVision,
+	"visionos":          PlatformNameVision,
+}
+
+// platformDeviceFamilies maps platform names to the device family of their apps.
+var platformDeviceFamilies = map[string]DeviceFamily{
+	PlatformNameIPhone:  DeviceFamilyIPhone,
+	PlatformNameIPad:    DeviceFamilyIPad,
+	PlatformNameMac:     DeviceFamilyMac,
+	PlatformNameAppleTV: DeviceFamilyTV,
+	PlatformNameWatch:   DeviceFamilyWatch,
+	PlatformNameVision:  DeviceFamilyVision,
+}
+
+// Platform represents the device platform App Store requests are made for,
+// e.g. iPhone apps or Mac App Store apps.
+type Platform struct {
+	name string
+}
+
+// NewPlatform creates a platform from its name, e.g. "iphone", "mac" or "appletv".
+// OS names such as "macOS", "tvOS" or "visionOS" are accepted as well.
+func NewPlatform(name string) (*Platform, error) {
+	canonical, ok := platformAliases[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidPlatform, name)
+	}
+
+	return &Platform{name: canonical}, nil
+}
+
+// DefaultPlatform returns the iPhone platform.
+func DefaultPlatform() *Platform {
+	return &Platform{name: PlatformNameIPhone}
+}
+
+// Name returns the platform name, e.g. "iphone".
+func (p *Platform) Name() string { return p.name }
+
+// DeviceFamily returns the device family of applications made for the platform.
+func (p *Platform) DeviceFamily() DeviceFamily { return platformDeviceFamilies[p.name] }
+
+// IsMobile reports whether the platform is iPhone or iPad.
+func (p *Platform) IsMobile() bool {
+	return p.name == PlatformNameIPhone || p.name == PlatformNameIPad
+}
+
+// Equals checks if two platforms are equal.
+func (p *Platform) Equals(other *Platform) bool {
+	if other == nil {
+		return false
+	}
+
+	return p.name == other.name
+}
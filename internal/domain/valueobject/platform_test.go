@@ -0,0 +1,117 @@
+package valueobject_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/valueobject"
+)
+
+func TestNewPlatform(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		input          string
+		expectedErr    error
+		expectedName   string
+		expectedFamily valueobject.DeviceFamily
+	}{
+		{"iPhone", "iphone", nil, "iphone", valueobject.DeviceFamilyIPhone},
+		{"iOS", "iOS", nil, "iphone", valueobject.DeviceFamilyIPhone},
+		{"iPadOS", "iPadOS", nil, "ipad", valueobject.DeviceFamilyIPad},
+		{"macOS", " macOS ", nil, "mac", valueobject.DeviceFamilyMac},
+		{"OS X", "osx", nil, "mac", valueobject.DeviceFamilyMac},
+		{"tvOS", "tvOS", nil, "appletv", valueobject.DeviceFamilyTV},
+		{"watchOS", "watchOS", nil, "watch", valueobject.DeviceFamilyWatch},
+		{"visionOS", "visionOS", nil, "vision", valueobject.DeviceFamilyVision},
+		{"Unknown", "android", valueobject.ErrInvalidPlatform, "", ""},
+		{"Empty", "", valueobject.ErrInvalidPlatform, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			platform, err := valueobject.NewPlatform(tt.input)
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("Expected error %v, got %v", tt.expectedErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if platform.Name() != tt.expectedName {
+				t.Errorf("Expected name %s, got %s", tt.expectedName, platform.Name())
+			}
+
+			if platform.DeviceFamily() != tt.expectedFamily {
+				t.Errorf("Expected device family %s, got %s", tt.expectedFamily, platform.DeviceFamily())
+			}
+		})
+	}
+}
+
+func TestPlatform_IsMobile(t *testing.T) {
+	t.Parallel()
+
+	if !valueobject.DefaultPlatform().IsMobile() {
+		t.Error("Expected the default platform to be mobile")
+	}
+
+	mac, err := valueobject.NewPlatform("mac")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if mac.IsMobile() {
+		t.Error("Expected Mac not to be mobile")
+	}
+
+	if mac.Equals(valueobject.DefaultPlatform()) || !mac.Equals(mac) || mac.Equals(nil) {
+		t.Error("Unexpected platform equality")
+	}
+}
+
+func TestNewDeviceFamilies(t *testing.T) {
+	t.Parallel()
+
+	families := valueobject.NewDeviceFamilies([]string{"ipod", "iphone", "mac", "unknown", "iphone", "realityDevice"})
+
+	if families.Len() != 4 {
+		t.Fatalf("Expected 4 device families, got %v", families.List())
+	}
+
+	if !families.SupportsIPhone() || !families.SupportsMac() || !families.SupportsVision() {
+		t.Errorf("Expected iPhone, Mac and Vision support, got %v", families.List())
+	}
+
+	if families.SupportsIPad() || families.SupportsTV() || families.SupportsWatch() || families.IsUniversal() {
+		t.Errorf("Unexpected device families %v", families.List())
+	}
+
+	platforms := families.Platforms()
+	if len(platforms) != 3 {
+		t.Fatalf("Expected 3 platforms, got %d", len(platforms))
+	}
+
+	for i, name := range []string{"iphone", "mac", "vision"} {
+		if platforms[i].Name() != name {
+			t.Errorf("Expected platform %d to be %s, got %s", i, name, platforms[i].Name())
+		}
+	}
+
+	tv, err := valueobject.NewPlatform("tvos")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if families.Supports(tv) || !families.Supports(valueobject.DefaultPlatform()) {
+		t.Error("Unexpected platform support")
+	}
+}
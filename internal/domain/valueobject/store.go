@@ -9,10 +9,22 @@ import (
 type Store struct {
 	region           string
 	xAppleStoreFront string
+	countryName      string
+	alpha3           string
+	language         string
+	currency         string
 	storeFront       int
 	hostPrefix       int
 }
 
+// StoreMetadata describes the country served by a storefront.
+type StoreMetadata struct {
+	CountryName string
+	Alpha3      string // ISO 3166-1 alpha-3 code
+	Language    string // Default language as a BCP 47 tag, e.g. "en-US"
+	Currency    string // ISO 4217 currency code
+}
+
 // NewStore creates a new Store value object.
 func NewStore(region string, storeFront, hostPrefix int) (*Store, error) {
 	region = strings.ToLower(strings.TrimSpace(region))
@@ -37,6 +49,31 @@ func NewStore(region string, storeFront, hostPrefix int) (*Store, error) {
 	}, nil
 }
 
+// NewStoreWithMetadata creates a Store value object carrying country metadata.
+func NewStoreWithMetadata(region string, storeFront, hostPrefix int, metadata StoreMetadata) (*Store, error) {
+	store, err := NewStore(region, storeFront, hostPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	alpha3 := strings.ToUpper(strings.TrimSpace(metadata.Alpha3))
+	if alpha3 != "" && len(alpha3) != 3 {
+		return nil, ErrInvalidAlpha3
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(metadata.Currency))
+	if currency != "" && len(currency) != 3 {
+		return nil, ErrInvalidCurrency
+	}
+
+	store.countryName = strings.TrimSpace(metadata.CountryName)
+	store.alpha3 = alpha3
+	store.language = strings.TrimSpace(metadata.Language)
+	store.currency = currency
+
+	return store, nil
+}
+
 // Region returns the region code.
 func (s *Store) Region() string { return s.region }
 
@@ -46,6 +83,31 @@ func (s *Store) StoreFront() int { return s.storeFront }
 // HostPrefix returns the host prefix.
 func (s *Store) HostPrefix() int { return s.hostPrefix }
 
+// Alpha2 returns the ISO 3166-1 alpha-2 country code.
+func (s *Store) Alpha2() string { return strings.ToUpper(s.region) }
+
+// Alpha3 returns the ISO 3166-1 alpha-3 country code.
+func (s *Store) Alpha3() string { return s.alpha3 }
+
+// CountryName returns the English name of the country.
+func (s *Store) CountryName() string { return s.countryName }
+
+// Language returns the default language of the storefront.
+func (s *Store) Language() string { return s.language }
+
+// Currency returns the ISO 4217 code of the storefront currency.
+func (s *Store) Currency() string { return s.currency }
+
+// Metadata returns the country metadata of the store.
+func (s *Store) Metadata() StoreMetadata {
+	return StoreMetadata{
+		CountryName: s.countryName,
+		Alpha3:      s.alpha3,
+		Language:    s.language,
+		Currency:    s.currency,
+	}
+}
+
 // XAppleStoreFront returns the X-Apple-Store-Front header value.
 func (s *Store) XAppleStoreFront() string { return s.xAppleStoreFront }
 
@@ -1,6 +1,7 @@
 package valueobject_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/truewebber/goitunes/v2/internal/domain/valueobject"
@@ -235,3 +236,67 @@ func TestStore_Equals(t *testing.T) {
 		})
 	}
 }
+
+func TestNewStoreWithMetadata(t *testing.T) {
+	t.Parallel()
+
+	store, err := valueobject.NewStoreWithMetadata("pl", 143478, 36, valueobject.StoreMetadata{
+		CountryName: "Poland",
+		Alpha3:      "pol",
+		Language:    "pl-PL",
+		Currency:    "pln",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if store.Alpha2() != "PL" {
+		t.Errorf("Expected alpha2 PL, got %s", store.Alpha2())
+	}
+
+	if store.Alpha3() != "POL" {
+		t.Errorf("Expected alpha3 POL, got %s", store.Alpha3())
+	}
+
+	if store.CountryName() != "Poland" {
+		t.Errorf("Expected country Poland, got %s", store.CountryName())
+	}
+
+	if store.Language() != "pl-PL" {
+		t.Errorf("Expected language pl-PL, got %s", store.Language())
+	}
+
+	if store.Currency() != "PLN" {
+		t.Errorf("Expected currency PLN, got %s", store.Currency())
+	}
+}
+
+func TestNewStoreWithMetadata_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		region        string
+		metadata      valueobject.StoreMetadata
+		expectedError error
+	}{
+		{"Empty region", "", valueobject.StoreMetadata{}, valueobject.ErrEmptyRegion},
+		{"Invalid alpha3", "pl", valueobject.StoreMetadata{Alpha3: "PO"}, valueobject.ErrInvalidAlpha3},
+		{"Invalid currency", "pl", valueobject.StoreMetadata{Currency: "ZLOTY"}, valueobject.ErrInvalidCurrency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store, err := valueobject.NewStoreWithMetadata(tt.region, 143478, 36, tt.metadata)
+			if !errors.Is(err, tt.expectedError) {
+				t.Errorf("Expected %v, got %v", tt.expectedError, err)
+			}
+
+			if store != nil {
+				t.Error("Store should be nil for error case")
+			}
+		})
+	}
+}
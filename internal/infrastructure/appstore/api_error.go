@@ -0,0 +1,151 @@
+package appstore
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/repository"
+	"github.com/truewebber/goitunes/v2/internal/infrastructure/config"
+)
+
+// Apple failure types reported in the failureType field of store responses.
+const (
+	failureTypeInvalidCredentials     = "-5000"
+	failureTypePasswordTokenExpired   = "2034"
+	failureTypeTemporarilyUnavailable = "2059"
+	failureTypeUnknownError           = "5002"
+	failureTypeLicenseNotFound        = "9610"
+)
+
+// customerMessageBadLogin is sent without a failure type when a login needs a two-factor code.
+const customerMessageBadLogin = "MZFinance.BadLogin.Configurator_message"
+
+// failureTypeErrors maps known failure types to domain errors.
+var failureTypeErrors = map[string]error{
+	failureTypeInvalidCredentials:     repository.ErrInvalidCredentials,
+	failureTypePasswordTokenExpired:   repository.ErrNotAuthenticated,
+	failureTypeTemporarilyUnavailable: repository.ErrTemporarilyUnavailable,
+	failureTypeLicenseNotFound:        repository.ErrPurchaseFailed,
+}
+
+// APIError describes a request the App Store answered with an error, either
+// with an unexpected HTTP status or with a failure type in the response body.
+type APIError struct {
+	// Err is the domain error the failure maps to, e.g. repository.ErrApplicationNotFound.
+	// It is nil when the failure has no known meaning.
+	Err error
+
+	// Endpoint is the request URL without query parameters.
+	Endpoint string
+
+	// FailureType is Apple's failureType code, e.g. "2034". Empty for plain HTTP errors.
+	FailureType string
+
+	// CustomerMessage is the message Apple shows to users, if any.
+	CustomerMessage string
+
+	// RequestID identifies the request in Apple's logs, if the response carried one.
+	RequestID string
+
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Retryable reports whether the same request may succeed when sent again later.
+	Retryable bool
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "app store request to %s failed with status %d", e.Endpoint, e.StatusCode)
+
+	if e.FailureType != "" {
+		fmt.Fprintf(&b, " and failureType %s", e.FailureType)
+	}
+
+	if e.CustomerMessage != "" {
+		fmt.Fprintf(&b, ": %s", e.CustomerMessage)
+	}
+
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, " (request ID %s)", e.RequestID)
+	}
+
+	return b.String()
+}
+
+// Unwrap returns ErrUnexpectedStatusCode for non-200 responses and the mapped domain error,
+// so both can be matched with errors.Is.
+func (e *APIError) Unwrap() []error {
+	errs := make([]error, 0, 2)
+
+	if e.StatusCode != http.StatusOK {
+		errs = append(errs, ErrUnexpectedStatusCode)
+	}
+
+	if e.Err != nil {
+		errs = append(errs, e.Err)
+	}
+
+	return errs
+}
+
+// newStatusError creates an APIError for a response with an unexpected status code.
+// notFound is the error a 404 maps to, nil if a missing resource has no domain meaning.
+func newStatusError(resp *http.Response, notFound error) *APIError {
+	apiErr := newAPIError(resp)
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		apiErr.Err = notFound
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		apiErr.Err = repository.ErrNotAuthenticated
+	}
+
+	return apiErr
+}
+
+// newFailureError creates an APIError for a response that reports a failure in its body.
+// fallback is the error used when the failure type is not known.
+func newFailureError(resp *http.Response, failureType, customerMessage string, fallback error) *APIError {
+	apiErr := newAPIError(resp)
+	apiErr.FailureType = failureType
+	apiErr.CustomerMessage = customerMessage
+	apiErr.Err = fallback
+
+	if err, ok := failureTypeErrors[failureType]; ok {
+		apiErr.Err = err
+	} else if failureType == "" && customerMessage == customerMessageBadLogin {
+		apiErr.Err = repository.ErrInvalidCredentials
+	}
+
+	if failureType == failureTypeTemporarilyUnavailable || failureType == failureTypeUnknownError {
+		apiErr.Retryable = true
+	}
+
+	return apiErr
+}
+
+// newAPIError fills the fields of an APIError shared by all failures of resp.
+func newAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get(config.HeaderXAppleRequestUUID),
+		Retryable:  resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError,
+	}
+
+	if apiErr.RequestID == "" {
+		apiErr.RequestID = resp.Header.Get(config.HeaderXAppleJingleCorrelationKey)
+	}
+
+	if resp.Request != nil && resp.Request.URL != nil {
+		endpoint := *resp.Request.URL
+		endpoint.RawQuery = ""
+		endpoint.Fragment = ""
+		apiErr.Endpoint = endpoint.String()
+	}
+
+	return apiErr
+}
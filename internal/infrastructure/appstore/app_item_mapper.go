@@ -0,0 +1,319 @@
+package appstore
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/entity"
+	"github.com/truewebber/goitunes/v2/internal/domain/service"
+	"github.com/truewebber/goitunes/v2/internal/domain/valueobject"
+	"github.com/truewebber/goitunes/v2/internal/infrastructure/appstore/model"
+)
+
+// softwareAssetFlavor is the flavor of the offer asset holding the application package.
+const softwareAssetFlavor = "iosSoftware"
+
+// appItemMapper maps application items of store responses to entities.
+type appItemMapper struct {
+	store           *valueobject.Store
+	currencyService *service.CurrencyService
+}
+
+// newAppItemMapper creates a mapper for items of store responses.
+func newAppItemMapper(store *valueobject.Store) *appItemMapper {
+	return &appItemMapper{
+		store:           store,
+		currencyService: service.NewCurrencyService(),
+	}
+}
+
+// toEntity maps API response in lang to domain entity.
+func (m *appItemMapper) toEntity(item *model.AppItemResponse, lang *valueobject.Language) *entity.Application {
+	app := entity.NewApplication(item.ID, item.BundleID, item.Name)
+	app.SetLocale(lang.Tag())
+	m.setBasicInfo(app, item)
+	m.setOffersInfo(app, item)
+	m.setGenresInfo(app, item)
+	m.setArtworkInfo(app, item)
+	m.setScreenshots(app, item)
+	m.setDescriptionInfo(app, item)
+	m.setReleaseDate(app, item)
+	m.setVersionHistory(app, item)
+	m.setFileSize(app, item)
+	m.setContentRating(app, item)
+
+	return app
+}
+
+// setBasicInfo sets basic application information.
+func (m *appItemMapper) setBasicInfo(app *entity.Application, item *model.AppItemResponse) {
+	app.SetArtistName(item.ArtistName)
+	app.SetArtistID(item.ArtistID)
+	app.SetRating(item.UserRating.Value, item.UserRating.RatingCount)
+	app.SetDeviceFamilies(item.DeviceFamilies)
+}
+
+// setOffersInfo sets every offer; the first one provides price and version.
+func (m *appItemMapper) setOffersInfo(app *entity.Application, item *model.AppItemResponse) {
+	if len(item.Offers) == 0 {
+		// Apple knows the app but does not sell it in this storefront
+		app.SetAvailable(false)
+
+		return
+	}
+
+	offers := make([]entity.Offer, 0, len(item.Offers))
+
+	for _, offer := range item.Offers {
+		assets := make([]entity.OfferAsset, 0, len(offer.Assets))
+		for _, asset := range offer.Assets {
+			assets = append(assets, entity.OfferAsset{Flavor: asset.Flavor, Size: int64(asset.Size)})
+		}
+
+		offers = append(offers, entity.Offer{
+			Type:           offer.Type,
+			Price:          offer.Price,
+			PriceFormatted: offer.PriceFormatted,
+			Currency:       m.currencyService.ResolveCurrency(m.store.Currency(), offer.Price, offer.PriceFormatted),
+			BuyParams:      offer.BuyParams,
+			Version:        offer.Version.Display,
+			VersionID:      int64(offer.Version.ExternalID),
+			Assets:         assets,
+		})
+	}
+
+	app.SetOffers(offers)
+	app.SetPrice(offers[0].Price, offers[0].Currency)
+	app.SetVersion(offers[0].Version, offers[0].VersionID)
+}
+
+// setGenresInfo sets every genre, falling back to genre names when IDs are missing.
+func (m *appItemMapper) setGenresInfo(app *entity.Application, item *model.AppItemResponse) {
+	genres := make([]entity.Genre, 0, max(len(item.Genres), len(item.GenreNames)))
+
+	for _, genre := range item.Genres {
+		genres = append(genres, entity.Genre{ID: genre.GenreID, Name: genre.Name, URL: genre.URL})
+	}
+
+	if len(genres) == 0 {
+		for _, name := range item.GenreNames {
+			genres = append(genres, entity.Genre{Name: name})
+		}
+	}
+
+	if len(genres) > 0 {
+		app.SetGenres(genres)
+	}
+}
+
+// setArtworkInfo sets artwork/icon information.
+func (m *appItemMapper) setArtworkInfo(app *entity.Application, item *model.AppItemResponse) {
+	if item.Artwork.URL == "" {
+		return
+	}
+
+	app.SetIconURL(item.Artwork.URL)
+	app.SetArtwork(entity.Artwork{
+		URLTemplate: item.Artwork.URL,
+		Width:       item.Artwork.Width,
+		Height:      item.Artwork.Height,
+	})
+}
+
+// setScreenshots sets screenshot URLs grouped by device type in a stable order.
+func (m *appItemMapper) setScreenshots(app *entity.Application, item *model.AppItemResponse) {
+	var screenshots []string
+
+	for _, deviceType := range slices.Sorted(maps.Keys(item.ScreenshotsByType)) {
+		for _, screen := range item.ScreenshotsByType[deviceType] {
+			screenshots = append(screenshots, screen.URL)
+		}
+	}
+
+	if len(screenshots) > 0 {
+		app.SetScreenshotURLs(screenshots)
+	}
+}
+
+// setDescriptionInfo sets description and minimum OS version.
+func (m *appItemMapper) setDescriptionInfo(app *entity.Application, item *model.AppItemResponse) {
+	app.SetDescription(item.Description.Standard)
+	app.SetMinimumOSVersion(item.MinimumOSVersion)
+}
+
+// setReleaseDate parses and sets release date.
+func (m *appItemMapper) setReleaseDate(app *entity.Application, item *model.AppItemResponse) {
+	if item.ReleaseDate == "" {
+		return
+	}
+
+	releaseDate, err := time.Parse(time.RFC3339, item.ReleaseDate)
+	if err == nil {
+		app.SetReleaseDate(releaseDate)
+	}
+}
+
+// setVersionHistory sets the version history and the release notes of the current version,
+// which is the entry matching the offered version or else the most recent one.
+func (m *appItemMapper) setVersionHistory(app *entity.Application, item *model.AppItemResponse) {
+	if len(item.VersionHistory) == 0 {
+		return
+	}
+
+	versions := make([]entity.Version, 0, len(item.VersionHistory))
+
+	for _, entry := range item.VersionHistory {
+		version := entity.Version{Version: entry.VersionString, ReleaseNotes: entry.ReleaseNotes}
+
+		// Older entries carry the date only
+		for _, layout := range []string{time.RFC3339, time.DateOnly} {
+			if releaseDate, err := time.Parse(layout, entry.ReleaseDate); err == nil {
+				version.ReleaseDate = releaseDate
+
+				break
+			}
+		}
+
+		versions = append(versions, version)
+	}
+
+	app.SetVersionHistory(versions)
+
+	history := app.VersionHistory()
+	current := history[len(history)-1]
+
+	for _, version := range history {
+		if version.Version == app.Version() {
+			current = version
+		}
+	}
+
+	app.SetReleaseNotes(current.ReleaseNotes)
+}
+
+// setFileSize sets the per-device sizes and the file size: the size of the
+// software asset of the first offer, or the largest per-device size.
+func (m *appItemMapper) setFileSize(app *entity.Application, item *model.AppItemResponse) {
+	sizes := make(map[string]int64, len(item.FileSizeByDevice))
+
+	var largest int64
+
+	for device, size := range item.FileSizeByDevice {
+		sizes[device] = int64(size)
+		largest = max(largest, int64(size))
+	}
+
+	app.SetFileSizeByDevice(sizes)
+
+	if offers := app.Offers(); len(offers) > 0 {
+		for _, asset := range offers[0].Assets {
+			if asset.Flavor == softwareAssetFlavor && asset.Size > 0 {
+				app.SetFileSize(asset.Size)
+
+				return
+			}
+		}
+
+		if assets := offers[0].Assets; len(assets) > 0 && assets[0].Size > 0 {
+			app.SetFileSize(assets[0].Size)
+
+			return
+		}
+	}
+
+	if largest > 0 {
+		app.SetFileSize(largest)
+	}
+}
+
+// setContentRating sets the copyright and the App Store age rating, or the rating
+// of the first other system when the App Store one is missing.
+func (m *appItemMapper) setContentRating(app *entity.Application, item *model.AppItemResponse) {
+	app.SetCopyright(item.Copyright)
+
+	if len(item.ContentRatingsBySystem) == 0 {
+		return
+	}
+
+	system := valueobject.ContentRatingSystemApple
+	if _, ok := item.ContentRatingsBySystem[system]; !ok {
+		system = slices.Sorted(maps.Keys(item.ContentRatingsBySystem))[0]
+	}
+
+	rating := item.ContentRatingsBySystem[system]
+
+	contentRating, err := valueobject.NewContentRating(system, rating.Name, rating.Rank, rating.Advisories)
+	if err == nil {
+		app.SetContentRating(contentRating)
+	}
+}
+
+// inAppPurchaseTypes maps the kinds of in-app purchases on application pages to entity types.
+var inAppPurchaseTypes = map[string]entity.InAppPurchaseType{
+	"consumable":                entity.InAppPurchaseTypeConsumable,
+	"nonconsumable":             entity.InAppPurchaseTypeNonConsumable,
+	"non-consumable":            entity.InAppPurchaseTypeNonConsumable,
+	"autorenewable":             entity.InAppPurchaseTypeAutoRenewable,
+	"autorenewablesubscription": entity.InAppPurchaseTypeAutoRenewable,
+	"auto-renewable":            entity.InAppPurchaseTypeAutoRenewable,
+	"nonrenewable":              entity.InAppPurchaseTypeNonRenewing,
+	"nonrenewingsubscription":   entity.InAppPurchaseTypeNonRenewing,
+	"non-renewing":              entity.InAppPurchaseTypeNonRenewing,
+}
+
+// toInAppPurchases maps in-app purchases of an application page to entities.
+// Prices are parsed from the formatted price in the storefront currency,
+// falling back to the numeric price when the formatted one cannot be parsed.
+func (m *appItemMapper) toInAppPurchases(items []model.InAppPurchaseResponse) []entity.InAppPurchase {
+	purchases := make([]entity.InAppPurchase, 0, len(items))
+
+	for _, item := range items {
+		purchase := entity.InAppPurchase{
+			AdamID:         item.AdamID,
+			Name:           item.Name,
+			Type:           m.inAppPurchaseType(item.Kind),
+			PriceFormatted: item.PriceFormatted,
+		}
+
+		if purchase.AdamID == "" {
+			purchase.AdamID = item.ID
+		}
+
+		var price float64
+
+		if len(item.Offers) > 0 {
+			price = item.Offers[0].Price
+
+			if item.Offers[0].PriceFormatted != "" {
+				purchase.PriceFormatted = item.Offers[0].PriceFormatted
+			}
+		}
+
+		purchase.Currency = m.currencyService.ResolveCurrency(m.store.Currency(), price, purchase.PriceFormatted)
+		purchase.Price = price
+
+		// The formatted price is exact where the numeric one may be missing; without
+		// digits, e.g. when it is not listed, the numeric price is kept.
+		if strings.ContainsFunc(purchase.PriceFormatted, unicode.IsDigit) {
+			if parsed, err := m.currencyService.ParsePrice(purchase.PriceFormatted, purchase.Currency); err == nil {
+				purchase.Price = parsed
+			}
+		}
+
+		purchases = append(purchases, purchase)
+	}
+
+	return purchases
+}
+
+// inAppPurchaseType returns the entity type of an in-app purchase kind.
+func (m *appItemMapper) inAppPurchaseType(kind string) entity.InAppPurchaseType {
+	if purchaseType, ok := inAppPurchaseTypes[strings.ToLower(strings.TrimSpace(kind))]; ok {
+		return purchaseType
+	}
+
+	return entity.InAppPurchaseTypeUnknown
+}
@@ -5,13 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"net/url"
+	"slices"
+	"strconv"
 	"strings"
-	"time"
 
 	"github.com/truewebber/goitunes/v2/internal/domain/entity"
-	"github.com/truewebber/goitunes/v2/internal/domain/service"
+	"github.com/truewebber/goitunes/v2/internal/domain/repository"
 	"github.com/truewebber/goitunes/v2/internal/domain/valueobject"
 	"github.com/truewebber/goitunes/v2/internal/infrastructure/appstore/model"
 	"github.com/truewebber/goitunes/v2/internal/infrastructure/config"
@@ -20,42 +22,152 @@ import (
 
 // ApplicationClient implements ApplicationRepository interface.
 type ApplicationClient struct {
-	httpClient      infrahttp.Client
-	store           *valueobject.Store
-	currencyService *service.CurrencyService
+	httpClient infrahttp.Client
+	store      *valueobject.Store
+	language   *valueobject.Language
+	platform   *valueobject.Platform
+	mapper     *appItemMapper
 }
 
 // NewApplicationClient creates a new application client.
 func NewApplicationClient(httpClient infrahttp.Client, store *valueobject.Store) *ApplicationClient {
 	return &ApplicationClient{
-		httpClient:      httpClient,
-		store:           store,
-		currencyService: service.NewCurrencyService(),
+		httpClient: httpClient,
+		store:      store,
+		mapper:     newAppItemMapper(store),
 	}
 }
 
-// FindByAdamID finds applications by their Adam IDs.
+// SetLanguage sets the language of returned metadata. Nil uses the store default.
+// A language set on the request context with repository.ContextWithLanguage takes precedence.
+func (c *ApplicationClient) SetLanguage(lang *valueobject.Language) *ApplicationClient {
+	c.language = lang
+
+	return c
+}
+
+// SetPlatform sets the platform applications are looked up for. Nil uses iPhone.
+func (c *ApplicationClient) SetPlatform(platform *valueobject.Platform) *ApplicationClient {
+	c.platform = platform
+
+	return c
+}
+
+// Platform returns the platform applications are looked up for.
+func (c *ApplicationClient) Platform() *valueobject.Platform {
+	return resolvePlatform(c.platform)
+}
+
+// Language returns the metadata language used for requests with ctx.
+func (c *ApplicationClient) Language(ctx context.Context) *valueobject.Language {
+	return resolveLanguage(ctx, c.language, c.store)
+}
+
+// FindByAdamID finds applications by their Adam IDs, in the order of adamIDs.
 func (c *ApplicationClient) FindByAdamID(ctx context.Context, adamIDs []string) ([]*entity.Application, error) {
-	return c.lookupApplications(ctx, "id", strings.Join(adamIDs, ","))
+	return c.lookupApplications(ctx, "id", adamIDs, (*entity.Application).AdamID)
 }
 
-// FindByBundleID finds applications by their Bundle IDs.
+// FindByBundleID finds applications by their Bundle IDs, in the order of bundleIDs.
 func (c *ApplicationClient) FindByBundleID(ctx context.Context, bundleIDs []string) ([]*entity.Application, error) {
-	return c.lookupApplications(ctx, "bundleId", strings.Join(bundleIDs, ","))
+	return c.lookupApplications(ctx, "bundleId", bundleIDs, (*entity.Application).BundleID)
 }
 
 // GetFullInfo retrieves detailed information about an application.
 func (c *ApplicationClient) GetFullInfo(ctx context.Context, adamID string) (*entity.Application, error) {
+	lang := c.Language(ctx)
+
+	_, item, err := c.fetchFullInfo(ctx, adamID, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.mapper.toEntity(item, lang), nil
+}
+
+// GetInAppPurchases retrieves the in-app purchases listed on the application page.
+func (c *ApplicationClient) GetInAppPurchases(ctx context.Context, adamID string) ([]entity.InAppPurchase, error) {
+	_, item, err := c.fetchFullInfo(ctx, adamID, c.Language(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.mapper.toInAppPurchases(item.TopInAppPurchases), nil
+}
+
+// GetPrivacyDetails retrieves the App Privacy label of the application page.
+func (c *ApplicationClient) GetPrivacyDetails(ctx context.Context, adamID string) (*entity.PrivacyDetails, error) {
+	_, item, err := c.fetchFullInfo(ctx, adamID, c.Language(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.mapper.toPrivacyDetails(&item.Privacy), nil
+}
+
+// GetRelated retrieves the related application sections of the application page.
+// Sections are returned in a fixed order; applications keep the page order and
+// related applications without data on the page are skipped.
+func (c *ApplicationClient) GetRelated(ctx context.Context, adamID string) ([]entity.RelatedApplications, error) {
+	lang := c.Language(ctx)
+
+	response, _, err := c.fetchFullInfo(ctx, adamID, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	sections := []struct {
+		section entity.RelatedSection
+		adamIDs []string
+	}{
+		{entity.RelatedSectionMoreByDeveloper, response.PageData.MoreByThisDeveloper},
+		{entity.RelatedSectionYouMightAlsoLike, response.PageData.CustomersAlsoBoughtApps},
+	}
+
+	related := make([]entity.RelatedApplications, 0, len(sections))
+
+	for _, section := range sections {
+		apps := make([]*entity.Application, 0, len(section.adamIDs))
+
+		for _, relatedID := range section.adamIDs {
+			if relatedID == adamID {
+				continue
+			}
+
+			item, ok := response.StorePlatformData.ProductDv.Results[relatedID]
+			if !ok {
+				item, ok = response.StorePlatformData.Lockup.Results[relatedID]
+			}
+
+			if ok {
+				apps = append(apps, c.mapper.toEntity(&item, lang))
+			}
+		}
+
+		related = append(related, entity.RelatedApplications{Section: section.section, Applications: apps})
+	}
+
+	return related, nil
+}
+
+// fetchFullInfo fetches the application page data in lang and
+// returns it together with the item of the application itself.
+func (c *ApplicationClient) fetchFullInfo(
+	ctx context.Context,
+	adamID string,
+	lang *valueobject.Language,
+) (*model.FullAppResponse, *model.AppItemResponse, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(config.NativeAppInfoURL, adamID), http.NoBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Add(config.HeaderXAppleStoreFront, c.store.XAppleStoreFrontWithDevice(config.IPhoneDeviceCode))
+	req.Header.Add(config.HeaderXAppleStoreFront, c.store.XAppleStoreFrontWithDevice(storeFrontDeviceCode(c.Platform())))
+	req.Header.Add(config.HeaderAcceptLanguage, lang.Tag())
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
+		return nil, nil, fmt.Errorf("failed to send request: %w", err)
 	}
 
 	defer func() {
@@ -66,26 +178,26 @@ func (c *ApplicationClient) GetFullInfo(ctx context.Context, adamID string) (*en
 	}()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: %d", ErrUnexpectedStatusCode, resp.StatusCode)
+		return nil, nil, newStatusError(resp, repository.ErrApplicationNotFound)
 	}
 
 	data, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
 	var response model.FullAppResponse
 
 	if err = json.Unmarshal(data, &response); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		return nil, nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
 	item, ok := response.StorePlatformData.ProductDv.Results[adamID]
 	if !ok {
-		return nil, fmt.Errorf("%w: %s", ErrAdamIDNotFound, adamID)
+		return nil, nil, fmt.Errorf("%w: %w: %s", repository.ErrApplicationNotFound, ErrAdamIDNotFound, adamID)
 	}
 
-	return c.mapToEntity(&item), nil
+	return &response, &item, nil
 }
 
 // GetRating retrieves rating information for an application.
@@ -100,7 +212,10 @@ func (c *ApplicationClient) GetRating(
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Add(config.HeaderXAppleStoreFront, c.store.XAppleStoreFrontWithDevice(config.IPhoneDeviceCode))
+	lang := c.Language(ctx)
+
+	req.Header.Add(config.HeaderXAppleStoreFront, c.store.XAppleStoreFrontWithDevice(storeFrontDeviceCode(c.Platform())))
+	req.Header.Add(config.HeaderAcceptLanguage, lang.Tag())
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -115,7 +230,7 @@ func (c *ApplicationClient) GetRating(
 	}()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: %d", ErrUnexpectedStatusCode, resp.StatusCode)
+		return nil, newStatusError(resp, repository.ErrApplicationNotFound)
 	}
 
 	data, err := io.ReadAll(resp.Body)
@@ -140,7 +255,7 @@ func (c *ApplicationClient) GetOverallRating(
 	ctx context.Context,
 	adamID string,
 ) (*entity.Rating, error) {
-	requestURL := fmt.Sprintf(config.OpenAppOverAllRatingInfoURL, adamID, c.store.Region())
+	requestURL := fmt.Sprintf(config.OpenAppOverAllRatingInfoURL, adamID, c.store.Region(), c.Language(ctx).Locale())
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, http.NoBody)
 	if err != nil {
@@ -160,7 +275,7 @@ func (c *ApplicationClient) GetOverallRating(
 	}()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: %d", ErrUnexpectedStatusCode, resp.StatusCode)
+		return nil, newStatusError(resp, repository.ErrApplicationNotFound)
 	}
 
 	data, err := io.ReadAll(resp.Body)
@@ -186,118 +301,87 @@ func (c *ApplicationClient) GetOverallRating(
 	}, nil
 }
 
-// mapToEntity maps API response to domain entity.
-func (c *ApplicationClient) mapToEntity(item *model.AppItemResponse) *entity.Application {
-	app := entity.NewApplication(item.ID, item.BundleID, item.Name)
-	c.setBasicInfo(app, item)
-	c.setOffersInfo(app, item)
-	c.setGenresInfo(app, item)
-	c.setArtworkInfo(app, item)
-	c.setScreenshots(app, item)
-	c.setDescriptionInfo(app, item)
-	c.setReleaseDate(app, item)
-	c.setFileSizeFromDevice(app, item)
-
-	return app
-}
-
-// setBasicInfo sets basic application information.
-func (c *ApplicationClient) setBasicInfo(app *entity.Application, item *model.AppItemResponse) {
-	app.SetArtistName(item.ArtistName)
-	app.SetArtistID(item.ArtistID)
-	app.SetRating(item.UserRating.Value, item.UserRating.RatingCount)
-	app.SetDeviceFamilies(item.DeviceFamilies)
-}
+// GetDeveloper retrieves a developer and the Adam IDs of up to limit of their applications from open API.
+func (c *ApplicationClient) GetDeveloper(ctx context.Context, artistID string, limit int) (*entity.Developer, error) {
+	requestURL := fmt.Sprintf(config.OpenArtistLookupURL,
+		url.QueryEscape(artistID), c.store.Region(), c.Language(ctx).Locale(), limit)
 
-// setOffersInfo sets offer-related information (price, version, file size).
-func (c *ApplicationClient) setOffersInfo(app *entity.Application, item *model.AppItemResponse) {
-	if len(item.Offers) == 0 {
-		return
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	offer := item.Offers[0]
-	currency := c.currencyService.ExtractCurrency(offer.Price, offer.PriceFormatted)
-	app.SetPrice(offer.Price, currency)
-	app.SetVersion(offer.Version.Display, int64(offer.Version.ExternalID))
-
-	if len(offer.Assets) > 0 {
-		app.SetFileSize(int64(offer.Assets[0].Size))
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
-}
 
-// setGenresInfo sets genre information.
-func (c *ApplicationClient) setGenresInfo(app *entity.Application, item *model.AppItemResponse) {
-	if len(item.Genres) > 0 {
-		app.SetGenre(item.Genres[0].GenreID, item.Genres[0].Name)
-	}
-}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			// Log error but don't fail the function
+			_ = closeErr
+		}
+	}()
 
-// setArtworkInfo sets artwork/icon information.
-func (c *ApplicationClient) setArtworkInfo(app *entity.Application, item *model.AppItemResponse) {
-	if item.Artwork.URL != "" {
-		app.SetIconURL(item.Artwork.URL)
+	if resp.StatusCode != http.StatusOK {
+		return nil, newStatusError(resp, repository.ErrDeveloperNotFound)
 	}
-}
 
-// setScreenshots sets screenshot URLs.
-func (c *ApplicationClient) setScreenshots(app *entity.Application, item *model.AppItemResponse) {
-	var screenshots []string
-
-	for _, screenList := range item.ScreenshotsByType {
-		for _, screen := range screenList {
-			screenshots = append(screenshots, screen.URL)
-		}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
-	if len(screenshots) > 0 {
-		app.SetScreenshotURLs(screenshots)
+	var response model.ArtistLookupResponse
+
+	if err = json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
-}
 
-// setDescriptionInfo sets description and minimum OS version.
-func (c *ApplicationClient) setDescriptionInfo(app *entity.Application, item *model.AppItemResponse) {
-	app.SetDescription(item.Description.Standard)
-	app.SetMinimumOSVersion(item.MinimumOSVersion)
-}
+	developer := &entity.Developer{ID: artistID, AppAdamIDs: make([]string, 0, len(response.Results))}
+	found := false
 
-// setReleaseDate parses and sets release date.
-func (c *ApplicationClient) setReleaseDate(app *entity.Application, item *model.AppItemResponse) {
-	if item.ReleaseDate == "" {
-		return
-	}
+	for _, result := range response.Results {
+		switch result.WrapperType {
+		case "artist":
+			found = true
+			developer.Name = result.ArtistName
+			developer.URL = result.ArtistLinkURL
+		case "software":
+			developer.AppAdamIDs = append(developer.AppAdamIDs, strconv.FormatInt(result.TrackID, 10))
 
-	releaseDate, err := time.Parse(time.RFC3339, item.ReleaseDate)
-	if err == nil {
-		app.SetReleaseDate(releaseDate)
+			if developer.URL == "" {
+				developer.URL = result.ArtistViewURL
+			}
+		}
 	}
-}
 
-// setFileSizeFromDevice sets file size from device-specific sizes.
-func (c *ApplicationClient) setFileSizeFromDevice(app *entity.Application, item *model.AppItemResponse) {
-	if len(item.FileSizeByDevice) == 0 {
-		return
+	if !found {
+		return nil, fmt.Errorf("%w: %s", repository.ErrDeveloperNotFound, artistID)
 	}
 
-	for _, size := range item.FileSizeByDevice {
-		app.SetFileSize(int64(size))
-
-		break
-	}
+	return developer, nil
 }
 
+// lookupApplications looks up the applications identified by ids and returns them
+// in the order of ids. key returns the identifier of an application; it is
+// compared case-insensitively because Apple may change the case of bundle IDs.
 func (c *ApplicationClient) lookupApplications(
 	ctx context.Context,
 	queryParamName string,
-	queryParamValue string,
+	ids []string,
+	key func(app *entity.Application) string,
 ) ([]*entity.Application, error) {
+	lang := c.Language(ctx)
+
 	query := url.Values{
 		"version":      []string{"2"},
-		queryParamName: []string{queryParamValue},
+		queryParamName: []string{strings.Join(ids, ",")},
 		"p":            []string{"mdm-lockup"},
 		"caller":       []string{"MDM"},
-		"platform":     []string{"itunes"},
+		"platform":     []string{lookupPlatform(c.Platform())},
 		"cc":           []string{c.store.Region()},
-		"l":            []string{"en_us"},
+		"l":            []string{lang.Locale()},
 	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, config.AppInfoURL+"?"+query.Encode(), http.NoBody)
@@ -305,6 +389,8 @@ func (c *ApplicationClient) lookupApplications(
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
+	req.Header.Add(config.HeaderAcceptLanguage, lang.Tag())
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send request: %w", err)
@@ -318,7 +404,7 @@ func (c *ApplicationClient) lookupApplications(
 	}()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: %d", ErrUnexpectedStatusCode, resp.StatusCode)
+		return nil, newStatusError(resp, repository.ErrApplicationNotFound)
 	}
 
 	data, err := io.ReadAll(resp.Body)
@@ -332,12 +418,28 @@ func (c *ApplicationClient) lookupApplications(
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
-	apps := make([]*entity.Application, 0, len(response.Results))
+	byKey := make(map[string]*entity.Application, len(response.Results))
+
+	for id := range response.Results {
+		item := response.Results[id]
+		app := c.mapper.toEntity(&item, lang)
+		byKey[strings.ToLower(key(app))] = app
+	}
+
+	apps := make([]*entity.Application, 0, len(byKey))
+
+	for _, id := range ids {
+		normalized := strings.ToLower(strings.TrimSpace(id))
+		if app, ok := byKey[normalized]; ok {
+			apps = append(apps, app)
+			delete(byKey, normalized)
+		}
+	}
 
-	for key := range response.Results {
-		item := response.Results[key]
-		app := c.mapToEntity(&item)
-		apps = append(apps, app)
+	// Apps Apple returned under another identifier, e.g. a redirected bundle ID,
+	// are kept after the requested ones in a stable order.
+	for _, normalized := range slices.Sorted(maps.Keys(byKey)) {
+		apps = append(apps, byKey[normalized])
 	}
 
 	return apps, nil
@@ -2,11 +2,13 @@ package appstore
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync/atomic"
 
 	"github.com/micromdm/plist"
 
@@ -21,6 +23,7 @@ type AuthClient struct {
 	httpClient infrahttp.Client
 	store      *valueobject.Store
 	device     *valueobject.Device
+	pod        atomic.Int64 // Last pod that answered a login, zero until discovered
 }
 
 // NewAuthClient creates a new authentication client.
@@ -36,7 +39,27 @@ func NewAuthClient(
 	}
 }
 
+// SetPod sets the pod to start authentication with, e.g. one discovered by an earlier login.
+// Non-positive values are ignored.
+func (c *AuthClient) SetPod(pod int) *AuthClient {
+	if pod > 0 {
+		c.pod.Store(int64(pod))
+	}
+
+	return c
+}
+
+// Pod returns the discovered pod, or the store host prefix if none was discovered yet.
+func (c *AuthClient) Pod() int {
+	if pod := c.pod.Load(); pod > 0 {
+		return int(pod)
+	}
+
+	return c.store.HostPrefix()
+}
+
 // Authenticate performs authentication with Apple ID and password.
+// The pod that answered the login is recorded in the returned credentials.
 func (c *AuthClient) Authenticate(
 	ctx context.Context,
 	appleID, password string,
@@ -59,6 +82,8 @@ func (c *AuthClient) Authenticate(
 		return nil, fmt.Errorf("credentials with token: %w", err)
 	}
 
+	credentials.SetPod(c.Pod())
+
 	return credentials, nil
 }
 
@@ -76,23 +101,13 @@ func (c *AuthClient) performAuthRequestWithRetry(
 
 	authResp, err := c.performAuthRequest(ctx, appleID, password, attempt)
 	if err != nil {
-		return nil, fmt.Errorf("perform auth request: %w", err)
-	}
-
-	// Check for errors
-	if authResp.FailureType != "" {
 		// First attempt with invalid credentials - retry once
-		if authResp.FailureType == "-5000" && attempt == 1 {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.FailureType == failureTypeInvalidCredentials && attempt == 1 {
 			return c.performAuthRequestWithRetry(ctx, appleID, password, attempt+1)
 		}
 
-		// Other failures - return error
-		errorMsg := authResp.CustomerMessage
-		if errorMsg == "" {
-			errorMsg = fmt.Sprintf("authentication failed (failureType: %s)", authResp.FailureType)
-		}
-
-		return nil, fmt.Errorf("%w: %s", ErrAuthenticationFailed, errorMsg)
+		return nil, fmt.Errorf("perform auth request: %w", err)
 	}
 
 	return authResp, nil
@@ -104,7 +119,7 @@ func (c *AuthClient) performAuthRequest(
 	appleID, password string,
 	attempt int,
 ) (*model.AuthResponse, error) {
-	return c.performAuthRequestWithPod(ctx, appleID, password, attempt, c.store.HostPrefix())
+	return c.performAuthRequestWithPod(ctx, appleID, password, attempt, c.Pod())
 }
 
 // performAuthRequestWithPod performs the authentication HTTP request with specific pod number.
@@ -138,11 +153,22 @@ func (c *AuthClient) performAuthRequestWithPod(
 		//nolint:errcheck // Error from Close is not critical here
 		_ = resp.Body.Close()
 
-		return nil, fmt.Errorf("%w: unexpected status code %d",
-			ErrUnexpectedStatusCode, resp.StatusCode)
+		return nil, newStatusError(resp, nil)
 	}
 
-	return c.parseAuthResponse(resp)
+	authResp, err := c.parseAuthResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remember the pod that answered so later requests go there directly
+	c.SetPod(pod)
+
+	if authResp.FailureType != "" || authResp.CustomerMessage == customerMessageBadLogin {
+		return nil, newFailureError(resp, authResp.FailureType, authResp.CustomerMessage, ErrAuthenticationFailed)
+	}
+
+	return authResp, nil
 }
 
 // buildLoginURL builds the login URL with pod number and query parameters.
@@ -166,28 +192,19 @@ func (c *AuthClient) handleRedirectResponse(
 	appleID, password string,
 	attempt int,
 ) (*model.AuthResponse, error) {
-	redirectURL := resp.Header.Get("Location")
-	if redirectURL == "" {
-		//nolint:errcheck // Error from Close is not critical here
-		_ = resp.Body.Close()
-
-		return nil, fmt.Errorf("%w: redirect location not found", ErrUnexpectedStatusCode)
+	redirectPod, err := podFromRedirect(resp)
+	if errors.Is(err, ErrPodNotFound) {
+		// Retry on the current pod when the redirect does not name one
+		redirectPod, err = c.Pod(), nil
 	}
 
-	// Read and close response body
-	//nolint:errcheck // Error from Copy and Close are not critical here
-	_, _ = io.Copy(io.Discard, resp.Body)
-	//nolint:errcheck // Error from Close is not critical here
-	_ = resp.Body.Close()
-
-	// Parse redirect URL to extract pod
-	redirectURLParsed, parseErr := url.Parse(strings.TrimSpace(redirectURL))
-	if parseErr != nil {
-		return nil, fmt.Errorf("failed to parse redirect URL: %w", parseErr)
+	if err != nil {
+		return nil, err
 	}
 
-	// Extract pod from redirect URL (query params or hostname)
-	redirectPod := c.extractPodFromRedirectURL(redirectURLParsed)
+	if attempt >= maxRetryAttempts {
+		return nil, fmt.Errorf("%w: last redirect to pod %d", ErrTooManyPodRedirects, redirectPod)
+	}
 
 	// Retry request with redirect pod and incremented attempt
 	return c.performAuthRequestWithPod(ctx, appleID, password, attempt+1, redirectPod)
@@ -238,42 +255,6 @@ func (c *AuthClient) buildLoginBody(appleID, password string, attempt int) *stri
 	return strings.NewReader(params.Encode())
 }
 
-// extractPodFromRedirectURL extracts pod number from redirect URL.
-func (c *AuthClient) extractPodFromRedirectURL(u *url.URL) int {
-	// Try to extract from query params first (Apple always provides it)
-	query := u.Query()
-
-	podStr := query.Get("Pod")
-	if podStr != "" {
-		var pod int
-		if _, err := fmt.Sscanf(podStr, "%d", &pod); err == nil {
-			return pod
-		}
-	}
-
-	// Fallback to extracting from hostname
-	return c.extractPodFromHost(u.Host)
-}
-
-// extractPodFromHost extracts pod number from hostname (e.g., p71-buy.itunes.apple.com -> 71).
-func (c *AuthClient) extractPodFromHost(host string) int {
-	if !strings.HasPrefix(host, "p") {
-		return c.store.HostPrefix()
-	}
-
-	parts := strings.Split(host, "-")
-	if len(parts) == 0 || !strings.HasPrefix(parts[0], "p") {
-		return c.store.HostPrefix()
-	}
-
-	var pod int
-	if _, err := fmt.Sscanf(parts[0], "p%d", &pod); err != nil {
-		return c.store.HostPrefix()
-	}
-
-	return pod
-}
-
 // addPodQueryParams adds Pod and PRH query parameters to URL if not present.
 func (c *AuthClient) addPodQueryParams(u *url.URL, pod int) {
 	query := u.Query()
@@ -296,8 +277,7 @@ func (c *AuthClient) parseAuthResponse(resp *http.Response) (*model.AuthResponse
 	}()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: %d, URL: %s",
-			ErrUnexpectedStatusCode, resp.StatusCode, resp.Request.URL.String())
+		return nil, newStatusError(resp, nil)
 	}
 
 	data, err := io.ReadAll(resp.Body)
@@ -22,8 +22,12 @@ import (
 type ChartClient struct {
 	httpClient      infrahttp.Client
 	store           *valueobject.Store
+	language        *valueobject.Language
+	platform        *valueobject.Platform
 	appRepo         repository.ApplicationRepository
 	currencyService *service.CurrencyService
+	mapper          *appItemMapper
+	lookup          *service.BatchLookup
 }
 
 // NewChartClient creates a new chart client.
@@ -37,9 +41,46 @@ func NewChartClient(
 		store:           store,
 		appRepo:         appRepo,
 		currencyService: service.NewCurrencyService(),
+		mapper:          newAppItemMapper(store),
+		lookup:          service.NewBatchLookup(),
 	}
 }
 
+// SetBatchLookup sets the batched lookup that resolves applications missing
+// from chart responses. Nil keeps the current one.
+func (c *ChartClient) SetBatchLookup(lookup *service.BatchLookup) *ChartClient {
+	if lookup != nil {
+		c.lookup = lookup
+	}
+
+	return c
+}
+
+// SetLanguage sets the language of returned metadata. Nil uses the store default.
+// A language set on the request context with repository.ContextWithLanguage takes precedence.
+func (c *ChartClient) SetLanguage(lang *valueobject.Language) *ChartClient {
+	c.language = lang
+
+	return c
+}
+
+// SetPlatform sets the platform whose charts are fetched. Nil uses iPhone.
+func (c *ChartClient) SetPlatform(platform *valueobject.Platform) *ChartClient {
+	c.platform = platform
+
+	return c
+}
+
+// Platform returns the platform whose charts are fetched.
+func (c *ChartClient) Platform() *valueobject.Platform {
+	return resolvePlatform(c.platform)
+}
+
+// Language returns the metadata language used for requests with ctx.
+func (c *ChartClient) Language(ctx context.Context) *valueobject.Language {
+	return resolveLanguage(ctx, c.language, c.store)
+}
+
 // GetTop200 retrieves the top 200 applications.
 func (c *ChartClient) GetTop200(
 	ctx context.Context,
@@ -47,12 +88,16 @@ func (c *ChartClient) GetTop200(
 	chartType entity.ChartType,
 	kidPrefix string,
 	from, limit int,
-) ([]*entity.ChartItem, error) {
+) (*entity.Chart, error) {
 	if from < 1 {
 		from = 1
 	}
 
-	response, err := c.fetchTop200Response(ctx, genreID, chartType, kidPrefix)
+	// Pin the language so lookups of missing apps return text in the chart's language.
+	lang := c.Language(ctx)
+	ctx = repository.ContextWithLanguage(ctx, lang)
+
+	response, err := c.fetchTop200Response(ctx, genreID, chartType, kidPrefix, lang)
 	if err != nil {
 		return nil, err
 	}
@@ -62,12 +107,15 @@ func (c *ChartClient) GetTop200(
 
 	topResults := response.StorePlatformData.Lockup.Results
 
-	infoResults, err := c.fetchMissingAppInfo(ctx, adamIDs, from, fromToChunk, topResults)
+	infoResults, lookupErrors, err := c.fetchMissingAppInfo(ctx, adamIDs, from, fromToChunk, topResults)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.buildChartItems(adamIDs, from, fromToChunk, topResults, infoResults, chartType), nil
+	chart := c.buildChart(adamIDs, from, fromToChunk, topResults, infoResults, lookupErrors, chartType, lang)
+	chart.SetTotalCount(len(adamIDs))
+
+	return chart, nil
 }
 
 // GetTop1500 retrieves up to 1500 applications.
@@ -77,7 +125,9 @@ func (c *ChartClient) GetTop1500(
 	chartType entity.ChartType,
 	page, pageSize int,
 ) ([]*entity.ChartItem, error) {
-	response, err := c.fetchTop1500Response(ctx, genreID, chartType, page, pageSize)
+	lang := c.Language(ctx)
+
+	response, err := c.fetchTop1500Response(ctx, genreID, chartType, page, pageSize, lang)
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +136,7 @@ func (c *ChartClient) GetTop1500(
 		return nil, ErrUnexpectedResponseStructure
 	}
 
-	return c.buildTop1500ChartItems(&response[0], page, pageSize, chartType), nil
+	return c.buildTop1500ChartItems(&response[0], page, pageSize, chartType, lang), nil
 }
 
 // fetchTop200Response fetches the top 200 response from API.
@@ -95,8 +145,17 @@ func (c *ChartClient) fetchTop200Response(
 	genreID string,
 	chartType entity.ChartType,
 	kidPrefix string,
+	lang *valueobject.Language,
 ) (*model.Top200Response, error) {
-	popID := c.chartTypeToPopID(chartType)
+	popID, err := chartPopID(c.Platform(), chartType)
+	if err != nil {
+		return nil, err
+	}
+
+	genreID, err = chartGenreID(c.Platform(), genreID, kidPrefix)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, config.Top200AppsURL, http.NoBody)
 	if err != nil {
@@ -112,9 +171,10 @@ func (c *ChartClient) fetchTop200Response(
 	q.Add("genreId", genreID)
 	q.Add("popId", popID)
 	q.Add("cc", c.store.Region())
-	q.Add("l", "en")
+	q.Add("l", lang.Base())
 	req.URL.RawQuery = q.Encode()
 	req.Header.Add(config.HeaderUserAgent, valueobject.UserAgentTop200)
+	req.Header.Add(config.HeaderAcceptLanguage, lang.Tag())
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -128,7 +188,7 @@ func (c *ChartClient) fetchTop200Response(
 	}()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: %d", ErrUnexpectedStatusCode, resp.StatusCode)
+		return nil, newStatusError(resp, nil)
 	}
 
 	data, err := io.ReadAll(resp.Body)
@@ -150,8 +210,17 @@ func (c *ChartClient) fetchTop1500Response(
 	genreID string,
 	chartType entity.ChartType,
 	page, pageSize int,
+	lang *valueobject.Language,
 ) ([]model.Top1500Response, error) {
-	popID := c.chartTypeToPopID(chartType)
+	popID, err := chartPopID(c.Platform(), chartType)
+	if err != nil {
+		return nil, err
+	}
+
+	genreID, err = chartGenreID(c.Platform(), genreID, "")
+	if err != nil {
+		return nil, err
+	}
 
 	q := url.Values{}
 	q.Add("genreId", genreID)
@@ -159,6 +228,7 @@ func (c *ChartClient) fetchTop1500Response(
 	q.Add("pageNumbers", fmt.Sprintf("%d", page))
 	q.Add("pageSize", fmt.Sprintf("%d", pageSize))
 	q.Add("cc", c.store.Region())
+	q.Add("l", lang.Base())
 
 	requestURL := fmt.Sprintf("%s?%s", config.TopAppsURL, q.Encode())
 
@@ -168,6 +238,7 @@ func (c *ChartClient) fetchTop1500Response(
 	}
 
 	req.Header.Add(config.HeaderUserAgent, valueobject.UserAgentTop1500)
+	req.Header.Add(config.HeaderAcceptLanguage, lang.Tag())
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -181,7 +252,7 @@ func (c *ChartClient) fetchTop1500Response(
 	}()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: %d", ErrUnexpectedStatusCode, resp.StatusCode)
+		return nil, newStatusError(resp, nil)
 	}
 
 	data, err := io.ReadAll(resp.Body)
@@ -214,15 +285,15 @@ func (c *ChartClient) calculateChunkRange(from, limit, totalLen int) int {
 	return fromToChunk
 }
 
-// fetchMissingAppInfo fetches missing application info in batches.
+// fetchMissingAppInfo fetches missing application info with the batched application lookup.
+// A failed batch does not fail the chart: the lookup error is returned for
+// every Adam ID of that batch. Only context cancellation aborts all batches.
 func (c *ChartClient) fetchMissingAppInfo(
 	ctx context.Context,
 	adamIDs []string,
 	from, fromToChunk int,
 	topResults map[string]model.AppItemResponse,
-) (map[string]*entity.Application, error) {
-	infoResults := make(map[string]*entity.Application)
-
+) (map[string]*entity.Application, map[string]error, error) {
 	var needGetInfo []string
 
 	for i := from - 1; i < fromToChunk; i++ {
@@ -231,54 +302,56 @@ func (c *ChartClient) fetchMissingAppInfo(
 		}
 	}
 
-	const batchSize = 50
-
-	for len(needGetInfo) > 0 {
-		currentBatchSize := batchSize
-		if len(needGetInfo) < batchSize {
-			currentBatchSize = len(needGetInfo)
-		}
-
-		batch := needGetInfo[:currentBatchSize]
-		needGetInfo = needGetInfo[currentBatchSize:]
-
-		apps, err := c.appRepo.FindByAdamID(ctx, batch)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get application info: %w", err)
-		}
+	infoResults, lookupErrors, err := c.lookup.Find(ctx, needGetInfo, c.appRepo.FindByAdamID, (*entity.Application).AdamID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get application info: %w", err)
+	}
 
-		for _, app := range apps {
-			infoResults[app.AdamID()] = app
-		}
+	for adamID, lookupErr := range lookupErrors {
+		lookupErrors[adamID] = fmt.Errorf("failed to get application info: %w", lookupErr)
 	}
 
-	return infoResults, nil
+	return infoResults, lookupErrors, nil
 }
 
-// buildChartItems builds chart items from available data.
-func (c *ChartClient) buildChartItems(
+// buildChart builds a chart from available data.
+// Positions without application info are reported as chart errors, so
+// callers can tell which positions were lost and why.
+func (c *ChartClient) buildChart(
 	adamIDs []string,
 	from, fromToChunk int,
 	topResults map[string]model.AppItemResponse,
 	infoResults map[string]*entity.Application,
+	lookupErrors map[string]error,
 	chartType entity.ChartType,
-) []*entity.ChartItem {
-	chartItems := make([]*entity.ChartItem, 0, fromToChunk-from+1)
+	lang *valueobject.Language,
+) *entity.Chart {
+	chart := entity.NewChart(make([]*entity.ChartItem, 0, fromToChunk-from+1))
 
 	for i := from - 1; i < fromToChunk; i++ {
 		position := i + 1
 		adamID := adamIDs[i]
 
-		app := c.getApplicationForChartItem(adamID, topResults, infoResults)
-		if app == nil {
+		app := c.getApplicationForChartItem(adamID, topResults, infoResults, lang)
+		if app != nil {
+			chart.AddItem(entity.NewChartItem(app, position, chartType))
+
 			continue
 		}
 
-		chartItem := entity.NewChartItem(app, position, chartType)
-		chartItems = append(chartItems, chartItem)
+		itemErr, failed := lookupErrors[adamID]
+		if !failed {
+			itemErr = entity.ErrChartItemNotFound
+		}
+
+		chart.AddError(&entity.ChartItemError{
+			AdamID:   adamID,
+			Position: position,
+			Err:      itemErr,
+		})
 	}
 
-	return chartItems
+	return chart
 }
 
 // getApplicationForChartItem gets application for chart item from available sources.
@@ -286,9 +359,10 @@ func (c *ChartClient) getApplicationForChartItem(
 	adamID string,
 	topResults map[string]model.AppItemResponse,
 	infoResults map[string]*entity.Application,
+	lang *valueobject.Language,
 ) *entity.Application {
 	if appInfo, ok := topResults[adamID]; ok {
-		return c.mapAppItemToEntity(&appInfo)
+		return c.mapper.toEntity(&appInfo, lang)
 	}
 
 	if appInfo, found := infoResults[adamID]; found {
@@ -303,6 +377,7 @@ func (c *ChartClient) buildTop1500ChartItems(
 	response *model.Top1500Response,
 	page, pageSize int,
 	chartType entity.ChartType,
+	lang *valueobject.Language,
 ) []*entity.ChartItem {
 	chartItems := make([]*entity.ChartItem, 0, len(response.ContentData))
 
@@ -310,7 +385,7 @@ func (c *ChartClient) buildTop1500ChartItems(
 		item := &response.ContentData[i]
 		position := page*pageSize + i + 1
 
-		app := c.buildAppFromTop1500Item(item)
+		app := c.buildAppFromTop1500Item(item, lang)
 		chartItem := entity.NewChartItem(app, position, chartType)
 		chartItems = append(chartItems, chartItem)
 	}
@@ -328,12 +403,13 @@ func (c *ChartClient) buildAppFromTop1500Item(item *struct {
 		VersionID    string `json:"versionId"`
 		ActionParams string `json:"actionParams"`
 	} `json:"buyData"`
-}) *entity.Application {
+}, lang *valueobject.Language) *entity.Application {
 	rating := c.parseRating(item.UserRating)
 	pc := c.parsePriceCurrency(item.BuyData.ActionParams, item.ButtonText)
 	versionID := c.parseVersionID(item.BuyData.VersionID)
 
 	app := entity.NewApplication(item.ID, item.BuyData.BundleID, "")
+	app.SetLocale(lang.Tag())
 	app.SetPrice(pc.price, pc.currency)
 	app.SetRating(rating, 0)
 	app.SetVersion("", versionID)
@@ -356,23 +432,34 @@ type priceCurrency struct {
 	price    float64
 }
 
-// parsePrice parses price and currency from buy params and button text.
+// parsePriceCurrency parses price and currency from buy params and button text.
+// The localised button text is used when the buy params carry no price.
 func (c *ChartClient) parsePriceCurrency(actionParams, buttonText string) priceCurrency {
 	buyParams, err := url.ParseQuery(actionParams)
-	if err != nil {
-		return priceCurrency{}
+	if err == nil && buyParams.Has("price") {
+		paramPrice, err := strconv.ParseInt(buyParams.Get("price"), 10, 64)
+		if err != nil {
+			return priceCurrency{}
+		}
+
+		const priceDivisor = 1000
+
+		price := float64(paramPrice) / priceDivisor
+
+		return priceCurrency{
+			price:    price,
+			currency: c.currencyService.ResolveCurrency(c.store.Currency(), price, buttonText),
+		}
 	}
 
-	paramPrice, err := strconv.ParseInt(buyParams.Get("price"), 10, 64)
+	price, err := c.currencyService.ParsePrice(buttonText, c.store.Currency())
 	if err != nil {
 		return priceCurrency{}
 	}
 
-	const priceDivisor = 1000
-
 	return priceCurrency{
-		price:    float64(paramPrice) / priceDivisor,
-		currency: c.currencyService.ExtractCurrency(float64(paramPrice), buttonText),
+		price:    price,
+		currency: c.currencyService.ResolveCurrency(c.store.Currency(), price, buttonText),
 	}
 }
 
@@ -385,62 +472,3 @@ func (c *ChartClient) parseVersionID(versionIDStr string) int64 {
 
 	return versionID
 }
-
-// chartTypeToPopID converts chart type to popID.
-func (c *ChartClient) chartTypeToPopID(chartType entity.ChartType) string {
-	switch chartType {
-	case entity.ChartTypeTopFree:
-		return config.PopIDTopFree
-	case entity.ChartTypeTopPaid:
-		return config.PopIDTopPaid
-	case entity.ChartTypeTopGrossing:
-		return config.PopIDTopGrossing
-	default:
-		return config.PopIDTopFree
-	}
-}
-
-// mapAppItemToEntity maps API response to entity.
-func (c *ChartClient) mapAppItemToEntity(item *model.AppItemResponse) *entity.Application {
-	app := entity.NewApplication(item.ID, item.BundleID, item.Name)
-	app.SetArtistName(item.ArtistName)
-	app.SetArtistID(item.ArtistID)
-	app.SetRating(item.UserRating.Value, item.UserRating.RatingCount)
-	app.SetDeviceFamilies(item.DeviceFamilies)
-
-	if len(item.Offers) > 0 {
-		offer := item.Offers[0]
-		currency := c.currencyService.ExtractCurrency(offer.Price, offer.PriceFormatted)
-		app.SetPrice(offer.Price, currency)
-		app.SetVersion(offer.Version.Display, int64(offer.Version.ExternalID))
-
-		if len(offer.Assets) > 0 {
-			app.SetFileSize(int64(offer.Assets[0].Size))
-		}
-	}
-
-	if len(item.Genres) > 0 {
-		app.SetGenre(item.Genres[0].GenreID, item.Genres[0].Name)
-	}
-
-	if item.Artwork.URL != "" {
-		app.SetIconURL(item.Artwork.URL)
-	}
-
-	var screenshots []string
-
-	for _, screenList := range item.ScreenshotsByType {
-		for _, screen := range screenList {
-			screenshots = append(screenshots, screen.URL)
-		}
-	}
-
-	if len(screenshots) > 0 {
-		app.SetScreenshotURLs(screenshots)
-	}
-
-	app.SetDescription(item.Description.Standard)
-	app.SetMinimumOSVersion(item.MinimumOSVersion)
-
-	return app
-}
@@ -50,6 +50,19 @@ var (
 	// ErrNoRatingFound is returned when no rating is found.
 	ErrNoRatingFound = errors.New("no rating found")
 
+	// ErrPodNotFound is returned when a redirect does not reveal the pod to use.
+	ErrPodNotFound = errors.New("pod not found in redirect location")
+
+	// ErrTooManyPodRedirects is returned when a request keeps being redirected to other pods.
+	ErrTooManyPodRedirects = errors.New("too many pod redirects")
+
+	// ErrChartsNotSupported is returned when the App Store has no charts for a platform.
+	ErrChartsNotSupported = errors.New("charts are not supported for platform")
+
+	// ErrGenreNotSupported is returned when a chart genre or age band belongs to
+	// another platform's genre tree, e.g. an iPhone genre on the Mac App Store.
+	ErrGenreNotSupported = errors.New("genre is not supported for platform")
+
 	// ErrUnexpectedResponseStructure is returned when response structure is unexpected.
 	ErrUnexpectedResponseStructure = errors.New("unexpected response structure")
 )
@@ -0,0 +1,115 @@
+package appstore
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/entity"
+	"github.com/truewebber/goitunes/v2/internal/infrastructure/appstore/model"
+	"github.com/truewebber/goitunes/v2/internal/infrastructure/config"
+)
+
+// GetGenres retrieves the App Store genre tree from the genre service. The Mac
+// platform retrieves the Mac App Store genre tree.
+func (c *ChartClient) GetGenres(ctx context.Context) ([]entity.GenreNode, error) {
+	lang := c.Language(ctx)
+	rootID := genreRootID(c.Platform())
+
+	q := url.Values{}
+	q.Add("id", rootID)
+	q.Add("cc", c.store.Region())
+	q.Add("l", lang.Base())
+
+	requestURL := fmt.Sprintf("%s?%s", config.GenresURL, q.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Add(config.HeaderAcceptLanguage, lang.Tag())
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			_ = closeErr
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newStatusError(resp, nil)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	var response model.GenresResponse
+	if err = json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	root, ok := response[rootID]
+	if !ok {
+		return nil, ErrUnexpectedResponseStructure
+	}
+
+	return appendGenreNodes(nil, &root, ""), nil
+}
+
+// appendGenreNodes appends genre and its subgenres, depth first, to nodes.
+// Subgenres are ordered by numeric genre ID so the tree is stable across refreshes.
+func appendGenreNodes(nodes []entity.GenreNode, genre *model.GenreResponse, parentID string) []entity.GenreNode {
+	nodes = append(nodes, entity.GenreNode{
+		ID:       genre.ID,
+		Name:     genre.Name,
+		ParentID: parentID,
+	})
+
+	ids := make([]string, 0, len(genre.Subgenres))
+	for id := range genre.Subgenres {
+		ids = append(ids, id)
+	}
+
+	slices.SortFunc(ids, compareGenreIDs)
+
+	for _, id := range ids {
+		subgenre := genre.Subgenres[id]
+		if subgenre.ID == "" {
+			subgenre.ID = id
+		}
+
+		nodes = appendGenreNodes(nodes, &subgenre, genre.ID)
+	}
+
+	return nodes
+}
+
+// compareGenreIDs orders numeric genre IDs by value and other IDs after them.
+func compareGenreIDs(a, b string) int {
+	x, errA := strconv.Atoi(a)
+	y, errB := strconv.Atoi(b)
+
+	switch {
+	case errA == nil && errB == nil:
+		return cmp.Compare(x, y)
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	default:
+		return cmp.Compare(a, b)
+	}
+}
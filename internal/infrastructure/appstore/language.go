@@ -0,0 +1,34 @@
+package appstore
+
+import (
+	"context"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/repository"
+	"github.com/truewebber/goitunes/v2/internal/domain/valueobject"
+	"github.com/truewebber/goitunes/v2/internal/infrastructure/config"
+)
+
+// resolveLanguage picks the metadata language of a request: the per-call language
+// from ctx, then the configured one, then the store default, then DefaultLanguage.
+func resolveLanguage(
+	ctx context.Context,
+	configured *valueobject.Language,
+	store *valueobject.Store,
+) *valueobject.Language {
+	if lang, ok := repository.LanguageFromContext(ctx); ok {
+		return lang
+	}
+
+	if configured != nil {
+		return configured
+	}
+
+	if lang, err := valueobject.NewLanguage(store.Language()); err == nil {
+		return lang
+	}
+
+	//nolint:errcheck // DefaultLanguage is a valid constant tag
+	lang, _ := valueobject.NewLanguage(config.DefaultLanguage)
+
+	return lang
+}
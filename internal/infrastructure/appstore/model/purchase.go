@@ -6,7 +6,9 @@ type PurchaseResponse struct {
 		DialogID    string `plist:"dialogId"`
 		MtRequestID string `plist:"mtRequestId"`
 	} `plist:"metrics"`
-	SongList []SongItem `plist:"songList"`
+	CustomerMessage string     `plist:"customerMessage,omitempty"`
+	FailureType     string     `plist:"failureType,omitempty"`
+	SongList        []SongItem `plist:"songList"`
 }
 
 // SongItem represents a downloadable item.
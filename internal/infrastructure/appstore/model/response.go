@@ -62,6 +62,13 @@ type AppItemResponse struct {
 		URL       string `json:"url"`
 		MediaType string `json:"mediaType"`
 	} `json:"genres"`
+	ContentRatingsBySystem map[string]struct {
+		Name       string   `json:"name"`
+		Advisories []string `json:"advisories"`
+		Value      int      `json:"value"`
+		Rank       int      `json:"rank"`
+	} `json:"contentRatingsBySystem"`
+	Copyright      string   `json:"copyright"`
 	GenreNames     []string `json:"genreNames"`
 	DeviceFamilies []string `json:"deviceFamilies"`
 	Offers         []struct {
@@ -87,6 +94,45 @@ type AppItemResponse struct {
 		Value       float64 `json:"value"`
 		RatingCount int     `json:"ratingCount"`
 	} `json:"userRating"`
+	TopInAppPurchases []InAppPurchaseResponse `json:"topInAppPurchases"`
+	Privacy           PrivacyResponse         `json:"privacy"`
+	VersionHistory    []struct {
+		VersionString string `json:"versionString"`
+		ReleaseNotes  string `json:"releaseNotes"`
+		ReleaseDate   string `json:"releaseDate"`
+	} `json:"versionHistory"`
+}
+
+// InAppPurchaseResponse represents an in-app purchase listed on an application page.
+type InAppPurchaseResponse struct {
+	ID     string `json:"id"`
+	AdamID string `json:"adamId"`
+	Name   string `json:"name"`
+	Kind   string `json:"kind"`
+	Offers []struct {
+		PriceFormatted string  `json:"priceFormatted"`
+		Price          float64 `json:"price"`
+	} `json:"offers"`
+	PriceFormatted string `json:"priceFormatted"`
+}
+
+// PrivacyResponse represents the App Privacy label of an application page.
+type PrivacyResponse struct {
+	PrivacyPolicyURL        string `json:"privacyPolicyUrl"`
+	ManagePrivacyChoicesURL string `json:"managePrivacyChoicesUrl"`
+	PrivacyTypes            []struct {
+		Identifier     string                    `json:"identifier"`
+		DataCategories []PrivacyCategoryResponse `json:"dataCategories"`
+		Purposes       []struct {
+			Identifier     string                    `json:"identifier"`
+			DataCategories []PrivacyCategoryResponse `json:"dataCategories"`
+		} `json:"purposes"`
+	} `json:"privacyTypes"`
+}
+
+// PrivacyCategoryResponse represents a data category of the App Privacy label.
+type PrivacyCategoryResponse struct {
+	Identifier string `json:"identifier"`
 }
 
 // LookupResponse represents the response from lookup API.
@@ -95,12 +141,34 @@ type LookupResponse struct {
 }
 
 // FullAppResponse represents detailed application information.
+// Related applications listed on the page are referenced by Adam ID in PageData
+// and described in the product-dv or lockup results.
 type FullAppResponse struct {
 	StorePlatformData struct {
 		ProductDv struct {
 			Results map[string]AppItemResponse `json:"results"`
 		} `json:"product-dv"`
+		Lockup struct {
+			Results map[string]AppItemResponse `json:"results"`
+		} `json:"lockup"`
 	} `json:"storePlatformData"`
+	PageData struct {
+		MoreByThisDeveloper     []string `json:"moreByThisDeveloper"`
+		CustomersAlsoBoughtApps []string `json:"customersAlsoBoughtApps"`
+	} `json:"pageData"`
+}
+
+// ArtistLookupResponse represents a developer and their applications from open API.
+// The developer comes first, with wrapper type "artist", followed by the applications.
+type ArtistLookupResponse struct {
+	Results []struct {
+		WrapperType   string `json:"wrapperType"`
+		ArtistName    string `json:"artistName"`
+		ArtistLinkURL string `json:"artistLinkUrl"`
+		ArtistViewURL string `json:"artistViewUrl"`
+		ArtistID      int64  `json:"artistId"`
+		TrackID       int64  `json:"trackId"`
+	} `json:"results"`
 }
 
 // RatingResponse represents rating information.
@@ -121,3 +189,13 @@ type OverallRatingResponse struct {
 		UserRatingCountForCurrentVersion   int     `json:"userRatingCountForCurrentVersion"`
 	} `json:"results"`
 }
+
+// GenresResponse represents a response of the genre service, keyed by genre ID.
+type GenresResponse map[string]GenreResponse
+
+// GenreResponse represents a genre of the genre service with its subgenres.
+type GenreResponse struct {
+	ID        string                   `json:"id"`
+	Name      string                   `json:"name"`
+	Subgenres map[string]GenreResponse `json:"subgenres"`
+}
@@ -0,0 +1,127 @@
+package appstore
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/entity"
+	"github.com/truewebber/goitunes/v2/internal/domain/valueobject"
+	"github.com/truewebber/goitunes/v2/internal/infrastructure/config"
+)
+
+// lookupPlatforms maps platform names to platform values of the lookup API.
+var lookupPlatforms = map[string]string{
+	valueobject.PlatformNameIPhone:  config.LookupPlatformIPhone,
+	valueobject.PlatformNameIPad:    config.LookupPlatformIPad,
+	valueobject.PlatformNameMac:     config.LookupPlatformMac,
+	valueobject.PlatformNameAppleTV: config.LookupPlatformAppleTV,
+	valueobject.PlatformNameWatch:   config.LookupPlatformWatch,
+	valueobject.PlatformNameVision:  config.LookupPlatformVision,
+}
+
+// chartPopIDs maps platform names to the popIds of their charts.
+// Apple TV, Apple Watch and Vision Pro apps have no charts of their own.
+var chartPopIDs = map[string]map[entity.ChartType]string{
+	valueobject.PlatformNameIPhone: {
+		entity.ChartTypeTopFree:     config.PopIDTopFree,
+		entity.ChartTypeTopPaid:     config.PopIDTopPaid,
+		entity.ChartTypeTopGrossing: config.PopIDTopGrossing,
+	},
+	valueobject.PlatformNameIPad: {
+		entity.ChartTypeTopFree:     config.PopIDIPadTopFree,
+		entity.ChartTypeTopPaid:     config.PopIDIPadTopPaid,
+		entity.ChartTypeTopGrossing: config.PopIDIPadTopGrossing,
+	},
+	valueobject.PlatformNameMac: {
+		entity.ChartTypeTopFree:     config.PopIDMacTopFree,
+		entity.ChartTypeTopPaid:     config.PopIDMacTopPaid,
+		entity.ChartTypeTopGrossing: config.PopIDMacTopGrossing,
+	},
+}
+
+// resolvePlatform returns platform, or the iPhone platform if it is nil.
+func resolvePlatform(platform *valueobject.Platform) *valueobject.Platform {
+	if platform == nil {
+		return valueobject.DefaultPlatform()
+	}
+
+	return platform
+}
+
+// lookupPlatform returns the lookup API platform value of platform.
+func lookupPlatform(platform *valueobject.Platform) string {
+	return lookupPlatforms[platform.Name()]
+}
+
+// storeFrontDeviceCode returns the X-Apple-Store-Front device code for platform.
+// Platforms without a storefront of their own are browsed like the iPhone store.
+func storeFrontDeviceCode(platform *valueobject.Platform) int {
+	switch platform.Name() {
+	case valueobject.PlatformNameIPad:
+		return config.IPadDeviceCode
+	case valueobject.PlatformNameMac:
+		return config.MacDeviceCode
+	default:
+		return config.IPhoneDeviceCode
+	}
+}
+
+// chartPopID returns the popId of the chart of chartType for platform.
+// Unknown chart types fall back to the top free chart.
+func chartPopID(platform *valueobject.Platform, chartType entity.ChartType) (string, error) {
+	popIDs, ok := chartPopIDs[platform.Name()]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrChartsNotSupported, platform.Name())
+	}
+
+	if popID, ok := popIDs[chartType]; ok {
+		return popID, nil
+	}
+
+	return popIDs[entity.ChartTypeTopFree], nil
+}
+
+// genreRootID returns the root genre of the genre tree of platform.
+func genreRootID(platform *valueobject.Platform) string {
+	if platform.Name() == valueobject.PlatformNameMac {
+		return config.MacAppStoreGenreID
+	}
+
+	return config.AppStoreGenreID
+}
+
+// chartGenreID returns the genreId of the chart of genreID for platform. The
+// Mac App Store charts its own genres, so the App Store root genre is mapped to
+// the Mac root genre and the other genres of one tree are rejected on the other.
+// Kids age bands only exist on the iPhone and iPad App Store.
+func chartGenreID(platform *valueobject.Platform, genreID, kidPrefix string) (string, error) {
+	if platform.Name() != valueobject.PlatformNameMac {
+		if isMacGenre(genreID) {
+			return "", fmt.Errorf("%w: genre %s on %s", ErrGenreNotSupported, genreID, platform.Name())
+		}
+
+		return genreID, nil
+	}
+
+	switch {
+	case kidPrefix != "":
+		return "", fmt.Errorf("%w: age band %s on %s", ErrGenreNotSupported, kidPrefix, platform.Name())
+	case genreID == config.AppStoreGenreID:
+		return config.MacAppStoreGenreID, nil
+	case !isMacGenre(genreID):
+		return "", fmt.Errorf("%w: genre %s on %s", ErrGenreNotSupported, genreID, platform.Name())
+	}
+
+	return genreID, nil
+}
+
+// isMacGenre reports whether genreID belongs to the Mac App Store genre tree.
+func isMacGenre(genreID string) bool {
+	if genreID == config.MacAppStoreGenreID {
+		return true
+	}
+
+	id, err := strconv.Atoi(genreID)
+
+	return err == nil && id >= config.MacGenreIDMin && id <= config.MacGenreIDMax
+}
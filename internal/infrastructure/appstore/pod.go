@@ -0,0 +1,57 @@
+package appstore
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// maxPodRedirects limits how many times an authenticated request follows a redirect to another pod.
+const maxPodRedirects = 3
+
+// podFromLocation extracts the pod number from a redirect URL, taken from the
+// "Pod" query parameter or from a host like p71-buy.itunes.apple.com.
+func podFromLocation(u *url.URL) (int, bool) {
+	// Try to extract from query params first (Apple always provides it)
+	var pod int
+	if podStr := u.Query().Get("Pod"); podStr != "" {
+		if _, err := fmt.Sscanf(podStr, "%d", &pod); err == nil && pod > 0 {
+			return pod, true
+		}
+	}
+
+	// Fallback to extracting from hostname
+	hostPart, _, _ := strings.Cut(u.Hostname(), "-")
+	if _, err := fmt.Sscanf(hostPart, "p%d", &pod); err == nil && pod > 0 {
+		return pod, true
+	}
+
+	return 0, false
+}
+
+// podFromRedirect reads the pod from the Location header of a 302 response and closes its body.
+func podFromRedirect(resp *http.Response) (int, error) {
+	//nolint:errcheck // Error from Copy is not critical here
+	_, _ = io.Copy(io.Discard, resp.Body)
+	//nolint:errcheck // Error from Close is not critical here
+	_ = resp.Body.Close()
+
+	location := strings.TrimSpace(resp.Header.Get("Location"))
+	if location == "" {
+		return 0, fmt.Errorf("%w: redirect location not found", ErrUnexpectedStatusCode)
+	}
+
+	locationURL, err := url.Parse(location)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse redirect URL: %w", err)
+	}
+
+	pod, ok := podFromLocation(locationURL)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrPodNotFound, location)
+	}
+
+	return pod, nil
+}
@@ -0,0 +1,90 @@
+package appstore
+
+import (
+	"github.com/truewebber/goitunes/v2/internal/domain/entity"
+	"github.com/truewebber/goitunes/v2/internal/infrastructure/appstore/model"
+)
+
+// privacyTypes maps App Privacy section identifiers to entity types.
+var privacyTypes = map[string]entity.PrivacyType{
+	"DATA_USED_TO_TRACK_YOU": entity.PrivacyTypeUsedToTrackYou,
+	"DATA_LINKED_TO_YOU":     entity.PrivacyTypeLinkedToYou,
+	"DATA_NOT_LINKED_TO_YOU": entity.PrivacyTypeNotLinkedToYou,
+	"DATA_NOT_COLLECTED":     entity.PrivacyTypeNotCollected,
+}
+
+// privacyCategories maps App Privacy data category identifiers to entity categories.
+var privacyCategories = map[string]entity.PrivacyCategory{
+	"CONTACT_INFO":       entity.PrivacyCategoryContactInfo,
+	"HEALTH_AND_FITNESS": entity.PrivacyCategoryHealthAndFitness,
+	"FINANCIAL_INFO":     entity.PrivacyCategoryFinancialInfo,
+	"LOCATION":           entity.PrivacyCategoryLocation,
+	"SENSITIVE_INFO":     entity.PrivacyCategorySensitiveInfo,
+	"CONTACTS":           entity.PrivacyCategoryContacts,
+	"USER_CONTENT":       entity.PrivacyCategoryUserContent,
+	"BROWSING_HISTORY":   entity.PrivacyCategoryBrowsingHistory,
+	"SEARCH_HISTORY":     entity.PrivacyCategorySearchHistory,
+	"IDENTIFIERS":        entity.PrivacyCategoryIdentifiers,
+	"PURCHASES":          entity.PrivacyCategoryPurchases,
+	"USAGE_DATA":         entity.PrivacyCategoryUsageData,
+	"DIAGNOSTICS":        entity.PrivacyCategoryDiagnostics,
+	"OTHER":              entity.PrivacyCategoryOther,
+}
+
+// privacyPurposes maps App Privacy purpose identifiers to entity purposes.
+var privacyPurposes = map[string]entity.PrivacyPurpose{
+	"THIRD_PARTY_ADVERTISING": entity.PrivacyPurposeThirdPartyAdvertising,
+	"DEVELOPERS_ADVERTISING":  entity.PrivacyPurposeDevelopersAdvertising,
+	"ANALYTICS":               entity.PrivacyPurposeAnalytics,
+	"PRODUCT_PERSONALIZATION": entity.PrivacyPurposeProductPersonalization,
+	"APP_FUNCTIONALITY":       entity.PrivacyPurposeAppFunctionality,
+	"OTHER_PURPOSES":          entity.PrivacyPurposeOther,
+}
+
+// toPrivacyDetails maps the App Privacy label of an application page to an entity.
+// Identifiers Apple adds later map to the unknown type, category or purpose.
+func (m *appItemMapper) toPrivacyDetails(privacy *model.PrivacyResponse) *entity.PrivacyDetails {
+	details := &entity.PrivacyDetails{
+		PolicyURL:        privacy.PrivacyPolicyURL,
+		ManageChoicesURL: privacy.ManagePrivacyChoicesURL,
+		Sections:         make([]entity.PrivacySection, 0, len(privacy.PrivacyTypes)),
+	}
+
+	for _, privacyType := range privacy.PrivacyTypes {
+		section := entity.PrivacySection{
+			Type:       lookupOr(privacyTypes, privacyType.Identifier, entity.PrivacyTypeUnknown),
+			Categories: m.toPrivacyCategories(privacyType.DataCategories),
+			Purposes:   make([]entity.PrivacyPurposeCategories, 0, len(privacyType.Purposes)),
+		}
+
+		for _, purpose := range privacyType.Purposes {
+			section.Purposes = append(section.Purposes, entity.PrivacyPurposeCategories{
+				Purpose:    lookupOr(privacyPurposes, purpose.Identifier, entity.PrivacyPurposeUnknown),
+				Categories: m.toPrivacyCategories(purpose.DataCategories),
+			})
+		}
+
+		details.Sections = append(details.Sections, section)
+	}
+
+	return details
+}
+
+// toPrivacyCategories maps App Privacy data categories to entity categories.
+func (m *appItemMapper) toPrivacyCategories(categories []model.PrivacyCategoryResponse) []entity.PrivacyCategory {
+	result := make([]entity.PrivacyCategory, 0, len(categories))
+	for _, category := range categories {
+		result = append(result, lookupOr(privacyCategories, category.Identifier, entity.PrivacyCategoryUnknown))
+	}
+
+	return result
+}
+
+// lookupOr returns the value of key in values, or fallback if there is none.
+func lookupOr[K comparable, V any](values map[K]V, key K, fallback V) V {
+	if value, ok := values[key]; ok {
+		return value
+	}
+
+	return fallback
+}
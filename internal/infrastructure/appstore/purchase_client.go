@@ -43,6 +43,48 @@ func NewPurchaseClient(
 	}
 }
 
+// Pod returns the pod used for authenticated requests: the one discovered during login
+// or by a later redirect, or the store host prefix if none was discovered.
+func (c *PurchaseClient) Pod() int {
+	if pod := c.credentials.Pod(); pod > 0 {
+		return pod
+	}
+
+	return c.store.HostPrefix()
+}
+
+// doOnPod sends the request built for the current pod. When Apple redirects to
+// another pod, the new pod is written back to the credentials and the request is
+// rebuilt and sent again.
+func (c *PurchaseClient) doOnPod(
+	buildRequest func(pod int) (*http.Request, error),
+) (*http.Response, error) {
+	for range maxPodRedirects + 1 {
+		req, err := buildRequest(c.Pod())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to send request: %w", err)
+		}
+
+		if resp.StatusCode != http.StatusFound {
+			return resp, nil
+		}
+
+		pod, err := podFromRedirect(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		c.credentials.SetPod(pod)
+	}
+
+	return nil, fmt.Errorf("%w: last redirect to pod %d", ErrTooManyPodRedirects, c.Pod())
+}
+
 // Purchase initiates a purchase for an application.
 func (c *PurchaseClient) Purchase(
 	ctx context.Context,
@@ -59,7 +101,7 @@ func (c *PurchaseClient) Purchase(
 	}
 
 	if err := c.validatePurchaseResponse(purchaseResp, adamID); err != nil {
-		return nil, fmt.Errorf("validate purchase response: %w", err)
+		return nil, fmt.Errorf("%w: validate purchase response: %w", repository.ErrPurchaseFailed, err)
 	}
 
 	song := purchaseResp.SongList[0]
@@ -88,21 +130,23 @@ func (c *PurchaseClient) ConfirmDownload(ctx context.Context, downloadID string)
 		"guid":        []string{c.device.GUID()},
 	}
 
-	requestURL := fmt.Sprintf(config.ConfirmDownloadTemplate, c.store.HostPrefix()) + "?" + query.Encode()
+	resp, err := c.doOnPod(func(pod int) (*http.Request, error) {
+		requestURL := fmt.Sprintf(config.ConfirmDownloadTemplate, pod) + "?" + query.Encode()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, http.NoBody)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, http.NoBody)
+		if err != nil {
+			return nil, err
+		}
 
-	req.Header.Add(config.HeaderUserAgent, valueobject.UserAgentDownload)
-	req.Header.Add(config.HeaderXAppleStoreFront, c.store.XAppleStoreFront())
-	req.Header.Add(config.HeaderXDsid, c.credentials.DSID())
-	req.Header.Add(config.HeaderXToken, c.credentials.PasswordToken())
+		req.Header.Add(config.HeaderUserAgent, valueobject.UserAgentDownload)
+		req.Header.Add(config.HeaderXAppleStoreFront, c.store.XAppleStoreFront())
+		req.Header.Add(config.HeaderXDsid, c.credentials.DSID())
+		req.Header.Add(config.HeaderXToken, c.credentials.PasswordToken())
 
-	resp, err := c.httpClient.Do(req)
+		return req, nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to send request: %w", err)
+		return err
 	}
 
 	defer func() {
@@ -113,7 +157,7 @@ func (c *PurchaseClient) ConfirmDownload(ctx context.Context, downloadID string)
 	}()
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("%w: %d", ErrUnexpectedStatusCode, resp.StatusCode)
+		return newStatusError(resp, nil)
 	}
 
 	return nil
@@ -195,26 +239,28 @@ func (c *PurchaseClient) buyApplication(
 	query := url.Values{
 		"xToken": {c.credentials.PasswordToken()},
 	}
-	requestURL := fmt.Sprintf(config.BuyProductURLTemplate, c.store.HostPrefix()) + "?" + query.Encode()
+	resp, err := c.doOnPod(func(pod int) (*http.Request, error) {
+		requestURL := fmt.Sprintf(config.BuyProductURLTemplate, pod) + "?" + query.Encode()
 
-	body := c.buildBuyBody(adamID, versionID, pricingParameter)
+		body := c.buildBuyBody(adamID, versionID, pricingParameter)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, body)
+		if err != nil {
+			return nil, err
+		}
 
-	req.Header.Add(config.HeaderContentType, config.ContentTypePlist)
-	req.Header.Add(config.HeaderReferer, fmt.Sprintf("http://itunes.apple.com/app/id%s", adamID))
-	req.Header.Add(config.HeaderUserAgent, c.device.UserAgent())
-	req.Header.Add(config.HeaderXAppleStoreFront, c.store.XAppleStoreFront())
-	req.Header.Add(config.HeaderXAppleTz, config.DefaultTimeZone)
-	req.Header.Add(config.HeaderXDsid, c.credentials.DSID())
-	req.Header.Add(config.HeaderXToken, c.credentials.PasswordToken())
+		req.Header.Add(config.HeaderContentType, config.ContentTypePlist)
+		req.Header.Add(config.HeaderReferer, fmt.Sprintf("http://itunes.apple.com/app/id%s", adamID))
+		req.Header.Add(config.HeaderUserAgent, c.device.UserAgent())
+		req.Header.Add(config.HeaderXAppleStoreFront, c.store.XAppleStoreFront())
+		req.Header.Add(config.HeaderXAppleTz, config.DefaultTimeZone)
+		req.Header.Add(config.HeaderXDsid, c.credentials.DSID())
+		req.Header.Add(config.HeaderXToken, c.credentials.PasswordToken())
 
-	resp, err := c.httpClient.Do(req)
+		return req, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
+		return nil, err
 	}
 
 	defer func() {
@@ -225,7 +271,7 @@ func (c *PurchaseClient) buyApplication(
 	}()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: %d", ErrUnexpectedStatusCode, resp.StatusCode)
+		return nil, newStatusError(resp, nil)
 	}
 
 	data, err := io.ReadAll(resp.Body)
@@ -240,6 +286,10 @@ func (c *PurchaseClient) buyApplication(
 		return nil, fmt.Errorf("failed to unmarshal response: %w", unmarshalErr)
 	}
 
+	if purchaseResp.FailureType != "" {
+		return nil, newFailureError(resp, purchaseResp.FailureType, purchaseResp.CustomerMessage, repository.ErrPurchaseFailed)
+	}
+
 	return &purchaseResp, nil
 }
 
@@ -17,9 +17,16 @@ const (
 	HeaderXToken           = "X-Token"
 	HeaderReferer          = "Referer"
 	HeaderCookie           = "Cookie"
+	HeaderAcceptLanguage   = "Accept-Language"
+
+	HeaderXAppleRequestUUID          = "X-Apple-Request-Uuid"
+	HeaderXAppleJingleCorrelationKey = "X-Apple-Jingle-Correlation-Key"
 )
 
 // Request parameters.
 const (
 	DefaultTimeZone = "0" // UTC
+
+	// DefaultLanguage is used for storefronts without a default language.
+	DefaultLanguage = "en-US"
 )
@@ -5,4 +5,13 @@ import "errors"
 var (
 	// ErrUnsupportedRegion is returned when the specified region is not supported.
 	ErrUnsupportedRegion = errors.New("unsupported region")
+
+	// ErrUnknownStoreFront is returned when no region uses the specified storefront ID.
+	ErrUnknownStoreFront = errors.New("unknown storefront")
+
+	// ErrStoreFrontInUse is returned when a storefront ID is registered for a second region.
+	ErrStoreFrontInUse = errors.New("storefront already registered for another region")
+
+	// ErrInvalidStoreFrontHeader is returned when an X-Apple-Store-Front value cannot be parsed.
+	ErrInvalidStoreFrontHeader = errors.New("invalid storefront header")
 )
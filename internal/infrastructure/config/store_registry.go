@@ -1,29 +1,73 @@
 package config
 
 import (
+	_ "embed"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/truewebber/goitunes/v2/internal/domain/valueobject"
 )
 
+// DefaultHostPrefix is the host prefix used for storefronts without a known pod.
+//
+// Only ae, ar, au, br, ca, cn, de, es, fr, gb, hk, id, in, it, jp, kr, mx, my, nl,
+// nz, pt, ru, sg, th, tr, tw, us, vn and za list the host prefix observed for them in
+// storefronts.json. Every other storefront is approximated with DefaultHostPrefix.
+// The prefix is only the first guess of the authentication pod: the pod that answers
+// a login is recorded in the credentials and used from then on.
+const DefaultHostPrefix = 36
+
+// storefrontsData lists every App Store storefront with its country metadata.
+//
+//go:embed storefronts.json
+var storefrontsData []byte
+
+// storefrontRecord is a single entry of storefronts.json.
+type storefrontRecord struct {
+	Region     string `json:"region"`
+	Alpha3     string `json:"alpha3"`
+	Country    string `json:"country"`
+	Language   string `json:"language"`
+	Currency   string `json:"currency"`
+	StoreFront int    `json:"storeFront"`
+	HostPrefix int    `json:"hostPrefix"`
+}
+
+// loadStorefronts parses the embedded storefront list once.
+var loadStorefronts = sync.OnceValues(func() ([]storefrontRecord, error) {
+	var records []storefrontRecord
+	if err := json.Unmarshal(storefrontsData, &records); err != nil {
+		return nil, fmt.Errorf("failed to parse storefronts: %w", err)
+	}
+
+	return records, nil
+})
+
 // StoreRegistry manages available App Store regions.
 type StoreRegistry struct {
-	stores map[string]*valueobject.Store
+	stores       map[string]*valueobject.Store
+	byStoreFront map[int]*valueobject.Store
 }
 
 // NewStoreRegistry creates a new store registry with all supported regions.
 func NewStoreRegistry() *StoreRegistry {
 	registry := &StoreRegistry{
-		stores: make(map[string]*valueobject.Store),
+		stores:       make(map[string]*valueobject.Store),
+		byStoreFront: make(map[int]*valueobject.Store),
 	}
 	registry.initialize()
 
 	return registry
 }
 
-// GetStore returns a store by region code.
+// GetStore returns a store by region code. The code is case-insensitive
+// and surrounding whitespace is ignored.
 func (r *StoreRegistry) GetStore(region string) (*valueobject.Store, error) {
-	store, exists := r.stores[region]
+	store, exists := r.stores[NormalizeRegion(region)]
 	if !exists {
 		return nil, fmt.Errorf("%w: %s", ErrUnsupportedRegion, region)
 	}
@@ -31,58 +75,90 @@ func (r *StoreRegistry) GetStore(region string) (*valueobject.Store, error) {
 	return store, nil
 }
 
-// GetAllRegions returns all supported region codes.
+// GetStoreByStoreFront returns a store by its numeric storefront ID.
+func (r *StoreRegistry) GetStoreByStoreFront(storeFront int) (*valueobject.Store, error) {
+	store, exists := r.byStoreFront[storeFront]
+	if !exists {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownStoreFront, storeFront)
+	}
+
+	return store, nil
+}
+
+// GetStoreByHeader returns a store by an X-Apple-Store-Front header value,
+// e.g. "143441,32" or "143441-1,29".
+func (r *StoreRegistry) GetStoreByHeader(header string) (*valueobject.Store, error) {
+	id, _, _ := strings.Cut(strings.TrimSpace(header), ",")
+	id, _, _ = strings.Cut(id, "-")
+
+	storeFront, err := strconv.Atoi(strings.TrimSpace(id))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidStoreFrontHeader, header)
+	}
+
+	return r.GetStoreByStoreFront(storeFront)
+}
+
+// GetAllRegions returns all supported region codes in alphabetical order.
 func (r *StoreRegistry) GetAllRegions() []string {
 	regions := make([]string, 0, len(r.stores))
 	for region := range r.stores {
 		regions = append(regions, region)
 	}
 
+	slices.Sort(regions)
+
 	return regions
 }
 
-// initialize populates the registry with all supported stores.
+// RegisterStore adds a store or replaces the store of an already registered region.
+// It fails if the storefront ID is already used by another region.
+func (r *StoreRegistry) RegisterStore(store *valueobject.Store) error {
+	if existing, exists := r.byStoreFront[store.StoreFront()]; exists && existing.Region() != store.Region() {
+		return fmt.Errorf("%w: %d is used by %s", ErrStoreFrontInUse, store.StoreFront(), existing.Region())
+	}
+
+	if previous, exists := r.stores[store.Region()]; exists {
+		delete(r.byStoreFront, previous.StoreFront())
+	}
+
+	r.stores[store.Region()] = store
+	r.byStoreFront[store.StoreFront()] = store
+
+	return nil
+}
+
+// NormalizeRegion converts a region code to the form used as registry key.
+func NormalizeRegion(region string) string {
+	return strings.ToLower(strings.TrimSpace(region))
+}
+
+// initialize populates the registry with all stores from the embedded storefront list.
+// The list is part of the build, so a list that cannot be parsed is a build defect
+// and panics instead of leaving every region unsupported.
 func (r *StoreRegistry) initialize() {
-	stores := []struct {
-		region     string
-		storeFront int
-		hostPrefix int
-	}{
-		{"us", 143441, 36},
-		{"ru", 143469, 45},
-		{"gb", 143444, 71},
-		{"ca", 143455, 71},
-		{"fr", 143442, 71},
-		{"hk", 143463, 71},
-		{"br", 143503, 36},
-		{"de", 143443, 36},
-		{"jp", 143462, 36},
-		{"id", 143476, 28},
-		{"kr", 143466, 55},
-		{"au", 143460, 55},
-		{"in", 143467, 12},
-		{"it", 143450, 12},
-		{"my", 143473, 55},
-		{"mx", 143468, 36},
-		{"nl", 143452, 38},
-		{"nz", 143461, 42},
-		{"sg", 143464, 42},
-		{"es", 143454, 40},
-		{"za", 143472, 50},
-		{"tw", 143470, 70},
-		{"th", 143475, 36},
-		{"ae", 143481, 36},
-		{"vn", 143471, 18},
-		{"cn", 143465, 33},
-		{"pt", 143453, 39},
-		{"tr", 143480, 39},
-		{"ar", 143505, 11},
+	records, err := loadStorefronts()
+	if err != nil {
+		panic(err)
 	}
 
-	for _, s := range stores {
-		store, err := valueobject.NewStore(s.region, s.storeFront, s.hostPrefix)
-		if err == nil {
-			r.stores[s.region] = store
+	for _, s := range records {
+		hostPrefix := s.HostPrefix
+		if hostPrefix == 0 {
+			hostPrefix = DefaultHostPrefix
 		}
+
+		store, err := valueobject.NewStoreWithMetadata(s.Region, s.StoreFront, hostPrefix, valueobject.StoreMetadata{
+			CountryName: s.Country,
+			Alpha3:      s.Alpha3,
+			Language:    s.Language,
+			Currency:    s.Currency,
+		})
+		if err != nil {
+			panic(fmt.Errorf("invalid embedded storefront %s: %w", s.Region, err))
+		}
+
+		r.stores[store.Region()] = store
+		r.byStoreFront[store.StoreFront()] = store
 	}
 }
@@ -0,0 +1,95 @@
+package config_test
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/internal/infrastructure/config"
+)
+
+func TestStorefrontsData(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("storefronts.json")
+	if err != nil {
+		t.Fatalf("Failed to read storefronts: %v", err)
+	}
+
+	var records []struct {
+		Region     string `json:"region"`
+		StoreFront int    `json:"storeFront"`
+	}
+
+	if err = json.Unmarshal(data, &records); err != nil {
+		t.Fatalf("Failed to parse storefronts: %v", err)
+	}
+
+	regions := make(map[string]bool, len(records))
+	storeFronts := make(map[int]string, len(records))
+
+	for _, record := range records {
+		if regions[record.Region] {
+			t.Errorf("Duplicate region %q", record.Region)
+		}
+
+		if region, exists := storeFronts[record.StoreFront]; exists {
+			t.Errorf("Storefront %d is used by %q and %q", record.StoreFront, region, record.Region)
+		}
+
+		regions[record.Region] = true
+		storeFronts[record.StoreFront] = record.Region
+	}
+
+	// Every record becomes a store; none is dropped as invalid.
+	if got := len(config.NewStoreRegistry().GetAllRegions()); got != len(records) {
+		t.Errorf("Expected %d registered regions, got %d", len(records), got)
+	}
+}
+
+// TestStorefrontsData_HostPrefixes keeps the storefronts documented at DefaultHostPrefix
+// in line with the ones storefronts.json lists a host prefix for.
+func TestStorefrontsData_HostPrefixes(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("storefronts.json")
+	if err != nil {
+		t.Fatalf("Failed to read storefronts: %v", err)
+	}
+
+	var records []struct {
+		Region     string `json:"region"`
+		HostPrefix int    `json:"hostPrefix"`
+	}
+
+	if err = json.Unmarshal(data, &records); err != nil {
+		t.Fatalf("Failed to parse storefronts: %v", err)
+	}
+
+	var known []string
+
+	registry := config.NewStoreRegistry()
+
+	for _, record := range records {
+		if record.HostPrefix > 0 {
+			known = append(known, record.Region)
+
+			continue
+		}
+
+		store, err := registry.GetStore(record.Region)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if store.HostPrefix() != config.DefaultHostPrefix {
+			t.Errorf("Expected %s to use DefaultHostPrefix, got %d", record.Region, store.HostPrefix())
+		}
+	}
+
+	expected := "ae,ar,au,br,ca,cn,de,es,fr,gb,hk,id,in,it,jp,kr,mx,my,nl,nz,pt,ru,sg,th,tr,tw,us,vn,za"
+	if got := strings.Join(known, ","); got != expected {
+		t.Errorf("Expected host prefixes for %s, got %s", expected, got)
+	}
+}
@@ -8,7 +8,9 @@ const (
 	AppInfoURL                  = "https://uclient-api.itunes.apple.com/WebObjects/MZStorePlatform.woa/wa/lookup"
 	NativeAppInfoURL            = "https://itunes.apple.com/app/id%s?mt=8"
 	NativeAppRatingInfoURL      = "https://itunes.apple.com/customer-reviews/id%s?dataOnly=true&displayable-kind=11"
-	OpenAppOverAllRatingInfoURL = "https://itunes.apple.com/lookup?id=%s&entity=software&country=%s"
+	OpenAppOverAllRatingInfoURL = "https://itunes.apple.com/lookup?id=%s&entity=software&country=%s&lang=%s"
+	OpenArtistLookupURL         = "https://itunes.apple.com/lookup?id=%s&entity=software&country=%s&lang=%s&limit=%d"
+	GenresURL                   = "https://itunes.apple.com/WebObjects/MZStoreServices.woa/ws/genres"
 
 	// Authenticated API endpoints (require login).
 	LoginURLTemplate        = "https://p%d-buy.itunes.apple.com/WebObjects/MZFinance.woa/wa/authenticate"
@@ -16,10 +18,32 @@ const (
 	ConfirmDownloadTemplate = "https://p%d-buy.itunes.apple.com/WebObjects/MZFastFinance.woa/wa/songDownloadDone"
 )
 
+// AppStoreGenreID is the root genre of the App Store genre tree.
+const AppStoreGenreID = "36"
+
+// Mac App Store genres. The Mac App Store has a genre tree of its own: the root
+// genre 39 and the 12xxx genres below it.
+const (
+	MacAppStoreGenreID = "39"
+	MacGenreIDMin      = 12000
+	MacGenreIDMax      = 12999
+)
+
 // Device codes for X-Apple-Store-Front header.
 const (
 	IPhoneDeviceCode = 29
 	IPadDeviceCode   = 32
+	MacDeviceCode    = 13
+)
+
+// Platform values of the lookup API.
+const (
+	LookupPlatformIPhone  = "itunes"
+	LookupPlatformIPad    = "ipad"
+	LookupPlatformMac     = "osx"
+	LookupPlatformAppleTV = "appletv"
+	LookupPlatformWatch   = "watch"
+	LookupPlatformVision  = "xros"
 )
 
 // Chart type identifiers.
@@ -33,6 +57,11 @@ const (
 	PopIDIPadTopFree     = "44"
 	PopIDIPadTopPaid     = "47"
 	PopIDIPadTopGrossing = "46"
+
+	// Mac App Store charts.
+	PopIDMacTopFree     = "34"
+	PopIDMacTopPaid     = "35"
+	PopIDMacTopGrossing = "37"
 )
 
 // Genre identifiers.
@@ -0,0 +1,71 @@
+package goitunes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/application/usecase"
+	"github.com/truewebber/goitunes/v2/internal/infrastructure/config"
+)
+
+// AvailabilityMatrix reports in which regions an application is sold, with its price,
+// currency and version per region.
+type AvailabilityMatrix = dto.AvailabilityDTO
+
+// RegionAvailability holds the availability of an application in a single region.
+type RegionAvailability = dto.RegionAvailabilityDTO
+
+// Availability looks up an application by Adam ID or Bundle ID in the given
+// regions concurrently, or in every supported region when none are given.
+// Regions selling a different version than most regions are flagged with VersionMismatch.
+// Use MultiRegionApplicationService.Availability to pass client options.
+func Availability(ctx context.Context, bundleIDOrAdamID string, regions ...string) (*AvailabilityMatrix, error) {
+	if len(regions) == 0 {
+		regions = config.NewStoreRegistry().GetAllRegions()
+	}
+
+	multi, err := NewMultiRegion(regions)
+	if err != nil {
+		return nil, err
+	}
+
+	return multi.Applications().Availability(ctx, bundleIDOrAdamID)
+}
+
+// Availability looks up an application by Adam ID or Bundle ID in every region
+// and reports where it is sold. Identifiers made of digits only are treated as Adam IDs.
+// A region that cannot be queried is reported with status LookupStatusFailed.
+func (s *MultiRegionApplicationService) Availability(
+	ctx context.Context,
+	bundleIDOrAdamID string,
+) (*AvailabilityMatrix, error) {
+	id := strings.TrimSpace(bundleIDOrAdamID)
+	if id == "" {
+		return nil, ErrInvalidRequest
+	}
+
+	var results RegionResults[LookupResults]
+
+	if strings.IndexFunc(id, func(r rune) bool { return !unicode.IsDigit(r) }) < 0 {
+		results = s.LookupByAdamID(ctx, id)
+	} else {
+		results = s.LookupByBundleID(ctx, id)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("failed to check availability: %w", err)
+	}
+
+	resultsByRegion := make(map[string]dto.LookupResultDTO, len(results))
+
+	for region, lookup := range results.Values() {
+		if len(lookup) > 0 {
+			resultsByRegion[region] = lookup[0]
+		}
+	}
+
+	return usecase.NewCheckAvailability().Execute(id, resultsByRegion, results.Errors()), nil
+}
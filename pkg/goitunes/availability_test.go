@@ -0,0 +1,109 @@
+package goitunes_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+// availabilityClient answers lookups with a per-region offer; regions without one return nothing.
+type availabilityClient struct {
+	offers map[string]string
+}
+
+func (c *availabilityClient) Do(req *http.Request) (*http.Response, error) {
+	query := req.URL.Query()
+	if query.Get("cc") == "gb" {
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Body:       io.NopCloser(strings.NewReader("")),
+			Request:    req,
+		}, nil
+	}
+
+	body := `{"results":{}}`
+
+	if offer, ok := c.offers[query.Get("cc")]; ok && query.Get("bundleId") == "com.test.app" {
+		body = `{"results":{"1":{"id":"1","bundleId":"com.test.app","name":"Test","offers":[` + offer + `]}}}`
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestMultiRegion_Availability(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &availabilityClient{offers: map[string]string{
+		"us": `{"price":1.99,"priceFormatted":"$1.99","version":{"display":"2.0"}}`,
+		"de": `{"price":2.29,"priceFormatted":"2,29 €","version":{"display":"2.0"}}`,
+		"jp": `{"price":300,"priceFormatted":"¥300","version":{"display":"1.9"}}`,
+		"fr": ``,
+	}}
+
+	multi, err := goitunes.NewMultiRegion([]string{"us", "de", "jp", "fr", "gb", "br"}, goitunes.WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	matrix, err := multi.Applications().Availability(context.Background(), "com.test.app")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if matrix.MajorityVersion != "2.0" {
+		t.Errorf("Expected majority version 2.0, got %s", matrix.MajorityVersion)
+	}
+
+	if got := matrix.AvailableRegions(); !slices.Equal(got, []string{"de", "jp", "us"}) {
+		t.Errorf("Expected availability in de, jp and us, got %v", got)
+	}
+
+	expected := map[string]struct {
+		status   goitunes.LookupStatus
+		currency string
+		mismatch bool
+	}{
+		"us": {goitunes.LookupStatusFound, "USD", false},
+		"de": {goitunes.LookupStatusFound, "EUR", false},
+		"jp": {goitunes.LookupStatusFound, "JPY", true},
+		"fr": {goitunes.LookupStatusNotAvailable, "", false},
+		"gb": {goitunes.LookupStatusFailed, "", false},
+		"br": {goitunes.LookupStatusNotFound, "", false},
+	}
+
+	for region, want := range expected {
+		got, ok := matrix.Region(region)
+		if !ok {
+			t.Errorf("Expected region %s in the matrix", region)
+
+			continue
+		}
+
+		if got.Status != want.status || got.Currency != want.currency || got.VersionMismatch != want.mismatch {
+			t.Errorf("Region %s: expected %+v, got %+v", region, want, got)
+		}
+	}
+
+	if us, _ := matrix.Region("us"); us.Price != 1.99 || us.Version != "2.0" {
+		t.Errorf("Unexpected us availability: %+v", us)
+	}
+}
+
+func TestAvailability_EmptyID(t *testing.T) {
+	t.Parallel()
+
+	_, err := goitunes.Availability(context.Background(), " ", "us")
+	if !errors.Is(err, goitunes.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest, got %v", err)
+	}
+}
@@ -0,0 +1,218 @@
+package goitunes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/application/usecase"
+	"github.com/truewebber/goitunes/v2/internal/domain/entity"
+	"github.com/truewebber/goitunes/v2/internal/domain/repository"
+	"github.com/truewebber/goitunes/v2/pkg/goitunes/storage"
+)
+
+// ChartStore persists chart snapshots and answers rank history queries.
+// Package sqlitestore provides a SQLite implementation.
+type ChartStore = storage.ChartStore
+
+// RankPoint is the position of an application in a tracked chart at a point in time.
+type RankPoint = dto.RankPointDTO
+
+// ChartHistoryService records charts of the client's storefront and platform into
+// a ChartStore and queries the rank history of applications.
+type ChartHistoryService struct {
+	recordUseCase *usecase.RecordChart
+	queryUseCase  *usecase.QueryChartHistory
+}
+
+// ChartHistory returns a chart history service that records into and queries store.
+func (c *Client) ChartHistory(store ChartStore) *ChartHistoryService {
+	device := c.chartRepo.Platform().Name()
+
+	adapter := chartStoreAdapter{store: store}
+
+	return &ChartHistoryService{
+		recordUseCase: usecase.NewRecordChart(usecase.NewGetTopCharts(c.chartRepo), adapter, c.store.Region(), device),
+		queryUseCase:  usecase.NewQueryChartHistory(adapter, c.store.Region(), device),
+	}
+}
+
+// Record fetches the top 200 chart of a genre and chart type, stores it and returns it as a snapshot.
+// Charts recorded WithAgeBand or WithMaxAgeRating are tracked apart from the full
+// chart; pass the same options to the queries to read their history.
+func (s *ChartHistoryService) Record(
+	ctx context.Context,
+	genre Genre,
+	chartType ChartType,
+	options ...Top200Option,
+) (*ChartSnapshot, error) {
+	snapshot, err := s.recordUseCase.Execute(ctx, historyRequest(genre, chartType, options))
+	if err != nil {
+		return nil, fmt.Errorf("failed to record chart: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// RankHistory returns the positions of an application in a chart recorded within
+// [from, to], oldest first. Zero times leave the range open. Options select a chart
+// recorded WithAgeBand or WithMaxAgeRating.
+func (s *ChartHistoryService) RankHistory(
+	ctx context.Context,
+	genre Genre,
+	chartType ChartType,
+	adamID string,
+	from, to time.Time,
+	options ...Top200Option,
+) ([]RankPoint, error) {
+	if adamID == "" {
+		return nil, fmt.Errorf("%w: adamID is required", ErrInvalidRequest)
+	}
+
+	history, err := s.queryUseCase.RankHistory(ctx, historyRequest(genre, chartType, options), adamID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rank history: %w", err)
+	}
+
+	return history, nil
+}
+
+// BestRank returns the highest position an application reached in a chart,
+// or ErrNoRankHistory if it was never recorded in it. Options select a chart
+// recorded WithAgeBand or WithMaxAgeRating.
+func (s *ChartHistoryService) BestRank(
+	ctx context.Context,
+	genre Genre,
+	chartType ChartType,
+	adamID string,
+	options ...Top200Option,
+) (*RankPoint, error) {
+	if adamID == "" {
+		return nil, fmt.Errorf("%w: adamID is required", ErrInvalidRequest)
+	}
+
+	best, err := s.queryUseCase.BestRank(ctx, historyRequest(genre, chartType, options), adamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get best rank: %w", err)
+	}
+
+	return best, nil
+}
+
+// DaysInTop returns the number of UTC days on which an application was recorded
+// at position topN or better, e.g. the days in the top 10. Options select a chart
+// recorded WithAgeBand or WithMaxAgeRating.
+func (s *ChartHistoryService) DaysInTop(
+	ctx context.Context,
+	genre Genre,
+	chartType ChartType,
+	adamID string,
+	topN int,
+	options ...Top200Option,
+) (int, error) {
+	if adamID == "" || topN < 1 {
+		return 0, fmt.Errorf("%w: adamID and a positive topN are required", ErrInvalidRequest)
+	}
+
+	days, err := s.queryUseCase.DaysInTop(ctx, historyRequest(genre, chartType, options), adamID, topN)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get days in top %d: %w", topN, err)
+	}
+
+	return days, nil
+}
+
+// historyRequest builds the top 200 request of a recorded chart.
+func historyRequest(genre Genre, chartType ChartType, options []Top200Option) *dto.GetTopChartsRequest {
+	req := dto.GetTopChartsRequest{
+		GenreID:   genre.String(),
+		ChartType: string(chartType),
+		From:      1,
+		Limit:     defaultTop200Limit,
+	}
+
+	for _, opt := range options {
+		opt(&req)
+	}
+
+	return &req
+}
+
+// chartStoreAdapter exposes a public ChartStore as the repository the use cases record into.
+type chartStoreAdapter struct {
+	store ChartStore
+}
+
+var _ repository.ChartStore = chartStoreAdapter{}
+
+func (a chartStoreAdapter) SaveSnapshot(
+	ctx context.Context,
+	key entity.ChartKey,
+	capturedAt time.Time,
+	items []*entity.ChartItem,
+) error {
+	entries := make([]storage.ChartEntry, 0, len(items))
+
+	for _, item := range items {
+		app := item.Application()
+		entries = append(entries, storage.ChartEntry{
+			AdamID:   app.AdamID(),
+			Currency: app.Currency(),
+			Position: item.Position(),
+			Price:    app.Price(),
+		})
+	}
+
+	//nolint:wrapcheck // errors of the caller's store are passed through unchanged
+	return a.store.SaveSnapshot(ctx, storageChartKey(key), capturedAt, entries)
+}
+
+func (a chartStoreAdapter) RankHistory(
+	ctx context.Context,
+	key entity.ChartKey,
+	adamID string,
+	from, to time.Time,
+) ([]entity.RankPoint, error) {
+	points, err := a.store.RankHistory(ctx, storageChartKey(key), adamID, from, to)
+	if err != nil {
+		//nolint:wrapcheck // errors of the caller's store are passed through unchanged
+		return nil, err
+	}
+
+	history := make([]entity.RankPoint, 0, len(points))
+	for _, point := range points {
+		history = append(history, entity.RankPoint(point))
+	}
+
+	return history, nil
+}
+
+func (a chartStoreAdapter) BestRank(ctx context.Context, key entity.ChartKey, adamID string) (*entity.RankPoint, error) {
+	point, err := a.store.BestRank(ctx, storageChartKey(key), adamID)
+	if err != nil {
+		//nolint:wrapcheck // errors of the caller's store are passed through unchanged
+		return nil, err
+	}
+
+	best := entity.RankPoint(*point)
+
+	return &best, nil
+}
+
+func (a chartStoreAdapter) DaysInTop(ctx context.Context, key entity.ChartKey, adamID string, topN int) (int, error) {
+	//nolint:wrapcheck // errors of the caller's store are passed through unchanged
+	return a.store.DaysInTop(ctx, storageChartKey(key), adamID, topN)
+}
+
+// storageChartKey converts a chart key to the key of the public ChartStore.
+func storageChartKey(key entity.ChartKey) storage.ChartKey {
+	return storage.ChartKey{
+		Storefront: key.Storefront,
+		GenreID:    key.GenreID,
+		ChartType:  string(key.ChartType),
+		Device:     key.Device,
+		AgeBand:    key.AgeBand,
+		MaxAge:     key.MaxAge,
+	}
+}
@@ -0,0 +1,93 @@
+package goitunes_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+	"github.com/truewebber/goitunes/v2/pkg/goitunes/storage"
+)
+
+// memoryChartStore is a storage.ChartStore implemented outside the module,
+// keeping the last saved snapshot.
+type memoryChartStore struct {
+	key     storage.ChartKey
+	entries []storage.ChartEntry
+}
+
+func (s *memoryChartStore) SaveSnapshot(
+	_ context.Context,
+	key storage.ChartKey,
+	_ time.Time,
+	entries []storage.ChartEntry,
+) error {
+	s.key, s.entries = key, entries
+
+	return nil
+}
+
+func (s *memoryChartStore) RankHistory(
+	_ context.Context,
+	_ storage.ChartKey,
+	_ string,
+	_, _ time.Time,
+) ([]storage.RankPoint, error) {
+	return nil, nil
+}
+
+func (s *memoryChartStore) BestRank(_ context.Context, key storage.ChartKey, adamID string) (*storage.RankPoint, error) {
+	for _, entry := range s.entries {
+		if key == s.key && entry.AdamID == adamID {
+			return &storage.RankPoint{Position: entry.Position, Price: entry.Price, Currency: entry.Currency}, nil
+		}
+	}
+
+	return nil, storage.ErrNoRankHistory
+}
+
+func (s *memoryChartStore) DaysInTop(_ context.Context, _ storage.ChartKey, _ string, _ int) (int, error) {
+	return 0, nil
+}
+
+func TestChartHistoryService_CustomStore(t *testing.T) {
+	t.Parallel()
+
+	client, err := goitunes.New("us",
+		goitunes.WithHTTPClient(&platformClient{chartBody: macChartBody}), goitunes.WithPlatform(goitunes.PlatformMac))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	store := &memoryChartStore{}
+	history := client.ChartHistory(store)
+
+	_, err = history.Record(context.Background(), goitunes.GenreAll, goitunes.ChartTypeTopFree)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedKey := storage.ChartKey{Storefront: "us", GenreID: "36", ChartType: "topfree", Device: "mac"}
+	if store.key != expectedKey {
+		t.Errorf("Expected key %+v, got %+v", expectedKey, store.key)
+	}
+
+	if len(store.entries) != 2 || store.entries[1].AdamID != "409201541" || store.entries[1].Position != 2 {
+		t.Fatalf("Unexpected entries %+v", store.entries)
+	}
+
+	best, err := history.BestRank(context.Background(), goitunes.GenreAll, goitunes.ChartTypeTopFree, "409201541")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if best.Position != 2 {
+		t.Errorf("Expected best position 2, got %d", best.Position)
+	}
+
+	_, err = history.BestRank(context.Background(), goitunes.GenreAll, goitunes.ChartTypeTopFree, "1")
+	if !errors.Is(err, goitunes.ErrNoRankHistory) {
+		t.Errorf("Expected ErrNoRankHistory, got %v", err)
+	}
+}
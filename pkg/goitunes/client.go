@@ -1,9 +1,11 @@
 package goitunes
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/truewebber/goitunes/v2/internal/application/usecase"
+	"github.com/truewebber/goitunes/v2/internal/domain/service"
 	"github.com/truewebber/goitunes/v2/internal/domain/valueobject"
 	"github.com/truewebber/goitunes/v2/internal/infrastructure/appstore"
 	"github.com/truewebber/goitunes/v2/internal/infrastructure/config"
@@ -18,6 +20,21 @@ type Client struct {
 	device        *valueobject.Device
 	storeRegistry *config.StoreRegistry
 
+	// language of returned metadata; nil uses the store default
+	language *valueobject.Language
+
+	// platform of looked up and charted applications; nil uses iPhone
+	platform *valueobject.Platform
+
+	// lookupBatchSize and lookupConcurrency control batched application lookups,
+	// of looked up applications and of chart entries alike
+	lookupBatchSize   int
+	lookupConcurrency int
+	batchLookup       *service.BatchLookup
+
+	// regionConcurrency limits concurrent regions of a MultiRegionClient
+	regionConcurrency int
+
 	// Repository implementations
 	appRepo      *appstore.ApplicationClient
 	chartRepo    *appstore.ChartClient
@@ -26,30 +43,32 @@ type Client struct {
 
 	// Services
 	chartService       *ChartService
+	genreService       *GenreService
 	applicationService *ApplicationService
 	authService        *AuthService
 	purchaseService    *PurchaseService
 }
 
 // New creates a new goitunes client for the specified region.
+// The region code is case-insensitive.
 func New(region string, opts ...Option) (*Client, error) {
-	storeRegistry := config.NewStoreRegistry()
-
-	store, storeErr := storeRegistry.GetStore(region)
-	if storeErr != nil {
-		return nil, fmt.Errorf("get store: %w", storeErr)
-	}
-
 	client := &Client{
-		store:         store,
 		httpClient:    infrahttp.NewDefaultClient(),
-		storeRegistry: storeRegistry,
+		storeRegistry: config.NewStoreRegistry(),
 	}
 
+	// Options are applied first so that stores added by WithCustomStore can be resolved.
 	if err := client.applyOptions(opts); err != nil {
 		return nil, fmt.Errorf("apply options: %w", err)
 	}
 
+	store, storeErr := client.storeRegistry.GetStore(region)
+	if storeErr != nil {
+		return nil, fmt.Errorf("get store: %w", storeErr)
+	}
+
+	client.store = store
+
 	client.setDefaultDevice()
 	client.initializeRepositories()
 	client.initializeServices()
@@ -62,6 +81,11 @@ func (c *Client) Charts() *ChartService {
 	return c.chartService
 }
 
+// Genres returns the genre service.
+func (c *Client) Genres() *GenreService {
+	return c.genreService
+}
+
 // Applications returns the application service.
 func (c *Client) Applications() *ApplicationService {
 	return c.applicationService
@@ -90,11 +114,40 @@ func (c *Client) Region() string {
 	return c.store.Region()
 }
 
-// SupportedRegions returns all supported regions.
+// Store returns the storefront of the current region.
+func (c *Client) Store() *Store {
+	return c.store
+}
+
+// Language returns the BCP 47 tag of the language metadata is requested in:
+// the one set with WithLanguage, or the default language of the store.
+func (c *Client) Language() string {
+	return c.appRepo.Language(context.Background()).Tag()
+}
+
+// Platform returns the platform applications are looked up and charted for:
+// the one set with WithPlatform, or PlatformIPhone.
+func (c *Client) Platform() Platform {
+	return Platform(c.appRepo.Platform().Name())
+}
+
+// SupportedRegions returns all supported regions in alphabetical order,
+// including stores added with WithCustomStore.
 func (c *Client) SupportedRegions() []string {
 	return c.storeRegistry.GetAllRegions()
 }
 
+// Pod returns the host prefix used for authenticated requests. It is the pod
+// discovered during login or by a later redirect, which is kept in the
+// credentials, or the store default.
+func (c *Client) Pod() int {
+	if c.credentials != nil && c.credentials.Pod() > 0 {
+		return c.credentials.Pod()
+	}
+
+	return c.store.HostPrefix()
+}
+
 // IsAuthenticated returns true if the client has valid credentials.
 func (c *Client) IsAuthenticated() bool {
 	return c.credentials != nil && c.credentials.IsAuthenticated()
@@ -137,11 +190,20 @@ func (c *Client) setDefaultDevice() {
 
 // initializeRepositories initializes repository implementations.
 func (c *Client) initializeRepositories() {
-	c.appRepo = appstore.NewApplicationClient(c.httpClient, c.store)
-	c.chartRepo = appstore.NewChartClient(c.httpClient, c.store, c.appRepo)
+	c.batchLookup = service.NewBatchLookup().
+		SetBatchSize(c.lookupBatchSize).
+		SetConcurrency(c.lookupConcurrency)
+	c.appRepo = appstore.NewApplicationClient(c.httpClient, c.store).
+		SetLanguage(c.language).
+		SetPlatform(c.platform)
+	c.chartRepo = appstore.NewChartClient(c.httpClient, c.store, c.appRepo).
+		SetBatchLookup(c.batchLookup).
+		SetLanguage(c.language).
+		SetPlatform(c.platform)
 
 	if c.credentials != nil {
-		c.authRepo = appstore.NewAuthClient(c.httpClient, c.store, c.device)
+		c.authRepo = appstore.NewAuthClient(c.httpClient, c.store, c.device).
+			SetPod(c.credentials.Pod())
 		c.purchaseRepo = appstore.NewPurchaseClient(
 			c.httpClient,
 			c.store,
@@ -153,12 +215,27 @@ func (c *Client) initializeRepositories() {
 
 // initializeServices initializes service implementations.
 func (c *Client) initializeServices() {
+	getTopCharts := usecase.NewGetTopCharts(c.chartRepo)
 	c.chartService = &ChartService{
-		useCase: usecase.NewGetTopCharts(c.chartRepo),
+		useCase:         getTopCharts,
+		snapshotUseCase: usecase.NewTakeChartSnapshot(getTopCharts, c.store.Region(), c.chartRepo.Platform().Name()),
+		platform:        Platform(c.chartRepo.Platform().Name()),
 	}
+
+	c.genreService = &GenreService{
+		useCase: usecase.NewGetGenreCatalog(c.chartRepo, c.store.Region()),
+		client:  c,
+	}
+
+	getAppInfo := usecase.NewGetApplicationInfo(c.appRepo).SetBatchLookup(c.batchLookup)
 	c.applicationService = &ApplicationService{
-		getInfoUseCase:   usecase.NewGetApplicationInfo(c.appRepo),
-		getRatingUseCase: usecase.NewGetRating(c.appRepo),
+		getInfoUseCase:           getAppInfo,
+		getRatingUseCase:         usecase.NewGetRating(c.appRepo),
+		getInAppPurchasesUseCase: usecase.NewGetInAppPurchases(c.appRepo, c.store.Region()),
+		getVersionHistoryUseCase: usecase.NewGetVersionHistory(c.appRepo),
+		getByDeveloperUseCase:    usecase.NewGetDeveloperApplications(c.appRepo, getAppInfo),
+		getRelatedUseCase:        usecase.NewGetRelated(c.appRepo),
+		getPrivacyUseCase:        usecase.NewGetPrivacyDetails(c.appRepo),
 	}
 
 	if c.authRepo != nil {
@@ -9,178 +9,308 @@ func (g Genre) String() string {
 }
 
 // IsValid checks if the genre is a valid known genre.
+// The deprecated Kids categories are valid for backward compatibility.
 func (g Genre) IsValid() bool {
-	_, exists := genreNames[g]
+	_, exists := builtinGenreCatalog.Genre(string(g))
 
-	return exists
+	return exists || AgeBand(g).IsValid()
 }
 
-// Name returns the human-readable name of the genre.
+// Name returns the human-readable English name of the genre.
+// Use a GenreCatalog for names in other languages.
 func (g Genre) Name() string {
-	return genreNames[g]
+	if name := builtinGenreCatalog.Name(string(g)); name != "" {
+		return name
+	}
+
+	return AgeBand(g).Name()
+}
+
+// Parent returns the parent genre, e.g. GenreGames for GenreGamesPuzzle.
+// It reports false for GenreAll and unknown genres.
+func (g Genre) Parent() (Genre, bool) {
+	parent, ok := builtinGenreCatalog.Parent(string(g))
+
+	return Genre(parent.ID), ok
+}
+
+// Subgenres returns the direct subgenres of the genre, e.g. the Games categories
+// for GenreGames.
+func (g Genre) Subgenres() []Genre {
+	nodes := builtinGenreCatalog.Subgenres(string(g))
+
+	subgenres := make([]Genre, 0, len(nodes))
+	for _, node := range nodes {
+		subgenres = append(subgenres, Genre(node.ID))
+	}
+
+	return subgenres
+}
+
+// SupportsPlatform reports whether charts of the genre can be fetched on platform.
+// Mac App Store charts use the GenreMac genres and GenreAll, iPhone and iPad
+// charts the other genres. The deprecated Kids genres are supported on no platform.
+// Platform aliases accepted by WithPlatform, such as "macOS", are accepted too.
+func (g Genre) SupportsPlatform(platform Platform) bool {
+	if _, exists := builtinGenreCatalog.Genre(string(g)); !exists {
+		return false
+	}
+
+	switch platform.canonical() {
+	case PlatformMac:
+		return g == GenreAll || g.isMac()
+	case PlatformIPhone, PlatformIPad:
+		return !g.isMac()
+	default:
+		return false
+	}
+}
+
+// isMac reports whether the genre belongs to the Mac App Store genre tree.
+func (g Genre) isMac() bool {
+	root := g
+	for parent, ok := root.Parent(); ok; parent, ok = root.Parent() {
+		root = parent
+	}
+
+	return root == GenreMacAll
 }
 
 // App Store genres.
 const (
-	GenreAll                        Genre = "36"           // All categories
-	GenreGames                      Genre = "6014"         // Games
-	GenreGamesAction                Genre = "7001"         // Games Action
-	GenreGamesAdventure             Genre = "7002"         // Games Adventure
-	GenreGamesArcade                Genre = "7003"         // Games Arcade
-	GenreGamesBoard                 Genre = "7004"         // Games Board
-	GenreGamesCard                  Genre = "7005"         // Games Card
-	GenreGamesCasino                Genre = "7006"         // Games Casino
-	GenreGamesDice                  Genre = "7007"         // Games Dice
-	GenreGamesEducational           Genre = "7008"         // Games Educational
-	GenreGamesFamily                Genre = "7009"         // Games Family
-	GenreGamesMusic                 Genre = "7011"         // Games Music
-	GenreGamesPuzzle                Genre = "7012"         // Games Puzzle
-	GenreGamesRacing                Genre = "7013"         // Games Racing
-	GenreGamesRolePlaying           Genre = "7014"         // Games Role-Playing
-	GenreGamesSimulation            Genre = "7015"         // Games Simulation
-	GenreGamesSports                Genre = "7016"         // Games Sports
-	GenreGamesStrategy              Genre = "7017"         // Games Strategy
-	GenreGamesTrivia                Genre = "7018"         // Games Trivia
-	GenreGamesWord                  Genre = "7019"         // Games Word
-	GenreShopping                   Genre = "6024"         // Shopping
-	GenreMagazinesNewspapers        Genre = "6021"         // Magazines & Newspapers
-	GenreMagazinesArtsPhotography   Genre = "13007"        // Magazines & Newspapers Arts & Photography
-	GenreMagazinesAutomotive        Genre = "13006"        // Magazines & Newspapers Automotive
-	GenreMagazinesBridesWeddings    Genre = "13008"        // Magazines & Newspapers Brides & Weddings
-	GenreMagazinesBusinessInvesting Genre = "13009"        // Magazines & Newspapers Business & Investing
-	GenreMagazinesChildrens         Genre = "13010"        // Magazines & Newspapers Children's Magazines
-	GenreMagazinesComputingInternet Genre = "13011"        // Magazines & Newspapers Computing & Internet
-	GenreMagazinesCookingFoodDrink  Genre = "13012"        // Magazines & Newspapers Cooking, Food & Drink
-	GenreMagazinesCraftsHobbies     Genre = "13013"        // Magazines & Newspapers Crafts & Hobbies
-	GenreMagazinesElectronicsAudio  Genre = "13014"        // Magazines & Newspapers Electronics & Audio
-	GenreMagazinesEntertainment     Genre = "13015"        // Magazines & Newspapers Entertainment
-	GenreMagazinesFashionStyle      Genre = "13002"        // Magazines & Newspapers Fashion & Style
-	GenreMagazinesFilmsMusic        Genre = "13021"        // Magazines & Newspapers Films & Music
-	GenreMagazinesHealthWellBeing   Genre = "13017"        // Magazines & Newspapers Health & Well-Being
-	GenreMagazinesHistory           Genre = "13018"        // Magazines & Newspapers History
-	GenreMagazinesHomeGarden        Genre = "13003"        // Magazines & Newspapers Home & Garden
-	GenreMagazinesLiteraryJournals  Genre = "13019"        // Magazines & Newspapers Literary Magazines & Journals
-	GenreMagazinesMensInterest      Genre = "13020"        // Magazines & Newspapers Men's Interest
-	GenreMagazinesNewsPolitics      Genre = "13001"        // Magazines & Newspapers News & Politics
-	GenreMagazinesOutdoorsNature    Genre = "13004"        // Magazines & Newspapers Outdoors & Nature
-	GenreMagazinesPets              Genre = "13024"        // Magazines & Newspapers Pets
-	GenreMagazinesProfessionalTrade Genre = "13025"        // Magazines & Newspapers Professional & Trade
-	GenreMagazinesScience           Genre = "13027"        // Magazines & Newspapers Science
-	GenreMagazinesSportsLeisure     Genre = "13005"        // Magazines & Newspapers Sports & Leisure
-	GenreMagazinesTeens             Genre = "13028"        // Magazines & Newspapers Teens
-	GenreMagazinesTravelRegional    Genre = "13029"        // Magazines & Newspapers Travel & Regional
-	GenreMagazinesWomensInterest    Genre = "13030"        // Magazines & Newspapers Women's Interest
-	GenreEducation                  Genre = "6017"         // Education
-	GenreBusiness                   Genre = "6000"         // Business
-	GenreKids                       Genre = "KIDS"         // Kids
-	GenreKidsLess5                  Genre = "KIDS_LESS_5"  // Kids 5 & Under
-	GenreKids6To8                   Genre = "KIDS_6_TO_8"  // Kids 6–8
-	GenreKids9To11                  Genre = "KIDS_9_TO_11" // Kids 9–11
-	GenreFoodDrink                  Genre = "6023"         // Food & Drink
-	GenreHealthFitness              Genre = "6013"         // Health & Fitness
-	GenreCatalogs                   Genre = "6022"         // Catalogs
-	GenreBooks                      Genre = "6018"         // Books
-	GenreMedical                    Genre = "6020"         // Medical
-	GenreMusic                      Genre = "6011"         // Music
-	GenreNavigation                 Genre = "6010"         // Navigation
-	GenreNews                       Genre = "6009"         // News
-	GenreLifestyle                  Genre = "6012"         // Lifestyle
-	GenreWeather                    Genre = "6001"         // Weather
-	GenreProductivity               Genre = "6007"         // Productivity
-	GenreTravel                     Genre = "6003"         // Travel
-	GenreEntertainment              Genre = "6016"         // Entertainment
-	GenreSocialNetworking           Genre = "6005"         // Social Networking
-	GenreSports                     Genre = "6004"         // Sports
-	GenreReference                  Genre = "6006"         // Reference
-	GenreUtilities                  Genre = "6002"         // Utilities
-	GenreFinance                    Genre = "6015"         // Finance
-	GenrePhotoVideo                 Genre = "6008"         // Photo & Video
+	GenreAll                        Genre = "36"    // All categories
+	GenreGames                      Genre = "6014"  // Games
+	GenreGamesAction                Genre = "7001"  // Games Action
+	GenreGamesAdventure             Genre = "7002"  // Games Adventure
+	GenreGamesArcade                Genre = "7003"  // Games Arcade
+	GenreGamesBoard                 Genre = "7004"  // Games Board
+	GenreGamesCard                  Genre = "7005"  // Games Card
+	GenreGamesCasino                Genre = "7006"  // Games Casino
+	GenreGamesDice                  Genre = "7007"  // Games Dice
+	GenreGamesEducational           Genre = "7008"  // Games Educational
+	GenreGamesFamily                Genre = "7009"  // Games Family
+	GenreGamesMusic                 Genre = "7011"  // Games Music
+	GenreGamesPuzzle                Genre = "7012"  // Games Puzzle
+	GenreGamesRacing                Genre = "7013"  // Games Racing
+	GenreGamesRolePlaying           Genre = "7014"  // Games Role-Playing
+	GenreGamesSimulation            Genre = "7015"  // Games Simulation
+	GenreGamesSports                Genre = "7016"  // Games Sports
+	GenreGamesStrategy              Genre = "7017"  // Games Strategy
+	GenreGamesTrivia                Genre = "7018"  // Games Trivia
+	GenreGamesWord                  Genre = "7019"  // Games Word
+	GenreShopping                   Genre = "6024"  // Shopping
+	GenreMagazinesNewspapers        Genre = "6021"  // Magazines & Newspapers
+	GenreMagazinesArtsPhotography   Genre = "13007" // Magazines & Newspapers Arts & Photography
+	GenreMagazinesAutomotive        Genre = "13006" // Magazines & Newspapers Automotive
+	GenreMagazinesBridesWeddings    Genre = "13008" // Magazines & Newspapers Brides & Weddings
+	GenreMagazinesBusinessInvesting Genre = "13009" // Magazines & Newspapers Business & Investing
+	GenreMagazinesChildrens         Genre = "13010" // Magazines & Newspapers Children's Magazines
+	GenreMagazinesComputingInternet Genre = "13011" // Magazines & Newspapers Computing & Internet
+	GenreMagazinesCookingFoodDrink  Genre = "13012" // Magazines & Newspapers Cooking, Food & Drink
+	GenreMagazinesCraftsHobbies     Genre = "13013" // Magazines & Newspapers Crafts & Hobbies
+	GenreMagazinesElectronicsAudio  Genre = "13014" // Magazines & Newspapers Electronics & Audio
+	GenreMagazinesEntertainment     Genre = "13015" // Magazines & Newspapers Entertainment
+	GenreMagazinesFashionStyle      Genre = "13002" // Magazines & Newspapers Fashion & Style
+	GenreMagazinesFilmsMusic        Genre = "13021" // Magazines & Newspapers Films & Music
+	GenreMagazinesHealthWellBeing   Genre = "13017" // Magazines & Newspapers Health & Well-Being
+	GenreMagazinesHistory           Genre = "13018" // Magazines & Newspapers History
+	GenreMagazinesHomeGarden        Genre = "13003" // Magazines & Newspapers Home & Garden
+	GenreMagazinesLiteraryJournals  Genre = "13019" // Magazines & Newspapers Literary Magazines & Journals
+	GenreMagazinesMensInterest      Genre = "13020" // Magazines & Newspapers Men's Interest
+	GenreMagazinesNewsPolitics      Genre = "13001" // Magazines & Newspapers News & Politics
+	GenreMagazinesOutdoorsNature    Genre = "13004" // Magazines & Newspapers Outdoors & Nature
+	GenreMagazinesPets              Genre = "13024" // Magazines & Newspapers Pets
+	GenreMagazinesProfessionalTrade Genre = "13025" // Magazines & Newspapers Professional & Trade
+	GenreMagazinesScience           Genre = "13027" // Magazines & Newspapers Science
+	GenreMagazinesSportsLeisure     Genre = "13005" // Magazines & Newspapers Sports & Leisure
+	GenreMagazinesTeens             Genre = "13028" // Magazines & Newspapers Teens
+	GenreMagazinesTravelRegional    Genre = "13029" // Magazines & Newspapers Travel & Regional
+	GenreMagazinesWomensInterest    Genre = "13030" // Magazines & Newspapers Women's Interest
+	GenreEducation                  Genre = "6017"  // Education
+	GenreBusiness                   Genre = "6000"  // Business
+	GenreFoodDrink                  Genre = "6023"  // Food & Drink
+	GenreHealthFitness              Genre = "6013"  // Health & Fitness
+	GenreCatalogs                   Genre = "6022"  // Catalogs
+	GenreBooks                      Genre = "6018"  // Books
+	GenreMedical                    Genre = "6020"  // Medical
+	GenreMusic                      Genre = "6011"  // Music
+	GenreNavigation                 Genre = "6010"  // Navigation
+	GenreNews                       Genre = "6009"  // News
+	GenreLifestyle                  Genre = "6012"  // Lifestyle
+	GenreWeather                    Genre = "6001"  // Weather
+	GenreProductivity               Genre = "6007"  // Productivity
+	GenreTravel                     Genre = "6003"  // Travel
+	GenreEntertainment              Genre = "6016"  // Entertainment
+	GenreSocialNetworking           Genre = "6005"  // Social Networking
+	GenreSports                     Genre = "6004"  // Sports
+	GenreReference                  Genre = "6006"  // Reference
+	GenreUtilities                  Genre = "6002"  // Utilities
+	GenreFinance                    Genre = "6015"  // Finance
+	GenrePhotoVideo                 Genre = "6008"  // Photo & Video
 )
 
-// genreNames maps genre IDs to their human-readable names.
+// Mac App Store genres. Mac charts only accept these genres and GenreAll, which
+// charts all Mac categories.
+const (
+	GenreMacAll              Genre = "39"    // Mac App Store, all categories
+	GenreMacBusiness         Genre = "12001" // Business
+	GenreMacDeveloperTools   Genre = "12002" // Developer Tools
+	GenreMacEducation        Genre = "12003" // Education
+	GenreMacEntertainment    Genre = "12004" // Entertainment
+	GenreMacFinance          Genre = "12005" // Finance
+	GenreMacGames            Genre = "12006" // Games
+	GenreMacHealthFitness    Genre = "12007" // Health & Fitness
+	GenreMacLifestyle        Genre = "12008" // Lifestyle
+	GenreMacMedical          Genre = "12010" // Medical
+	GenreMacMusic            Genre = "12011" // Music
+	GenreMacNews             Genre = "12012" // News
+	GenreMacPhotography      Genre = "12013" // Photography
+	GenreMacProductivity     Genre = "12014" // Productivity
+	GenreMacReference        Genre = "12015" // Reference
+	GenreMacSocialNetworking Genre = "12016" // Social Networking
+	GenreMacSports           Genre = "12017" // Sports
+	GenreMacTravel           Genre = "12018" // Travel
+	GenreMacUtilities        Genre = "12019" // Utilities
+	GenreMacVideo            Genre = "12020" // Video
+	GenreMacWeather          Genre = "12021" // Weather
+	GenreMacGraphicsDesign   Genre = "12022" // Graphics & Design
+)
+
+// Kids categories.
+//
+// Deprecated: Kids categories are age bands, not genres. Pass AgeBandKids,
+// AgeBandFiveAndUnder, AgeBandSixToEight or AgeBandNineToEleven to charts with
+// WithAgeBand instead.
+const (
+	GenreKids      = Genre(AgeBandKids)
+	GenreKidsLess5 = Genre(AgeBandFiveAndUnder)
+	GenreKids6To8  = Genre(AgeBandSixToEight)
+	GenreKids9To11 = Genre(AgeBandNineToEleven)
+)
+
+// genreTreeNode is a built-in genre with its parent.
+type genreTreeNode struct {
+	genre  Genre
+	parent Genre
+	name   string
+}
+
+// genreTree lists the built-in App Store genres with their parents, parents before
+// their subgenres. Kids categories are age bands, not genres; see AgeBand.
 //
-//nolint:gochecknoglobals // map is acceptable as a global variable
-var genreNames = map[Genre]string{
+//nolint:gochecknoglobals // table is acceptable as a global variable
+var genreTree = []genreTreeNode{
 	// Main genres
-	GenreAll:                 "All Categories",
-	GenreGames:               "Games",
-	GenreShopping:            "Shopping",
-	GenreMagazinesNewspapers: "Magazines & Newspapers",
-	GenreEducation:           "Education",
-	GenreBusiness:            "Business",
-	GenreKids:                "Kids",
-	GenreFoodDrink:           "Food & Drink",
-	GenreHealthFitness:       "Health & Fitness",
-	GenreCatalogs:            "Catalogs",
-	GenreBooks:               "Books",
-	GenreMedical:             "Medical",
-	GenreMusic:               "Music",
-	GenreNavigation:          "Navigation",
-	GenreNews:                "News",
-	GenreLifestyle:           "Lifestyle",
-	GenreWeather:             "Weather",
-	GenreProductivity:        "Productivity",
-	GenreTravel:              "Travel",
-	GenreEntertainment:       "Entertainment",
-	GenreSocialNetworking:    "Social Networking",
-	GenreSports:              "Sports",
-	GenreReference:           "Reference",
-	GenreUtilities:           "Utilities",
-	GenreFinance:             "Finance",
-	GenrePhotoVideo:          "Photo & Video",
+	{GenreAll, "", "All Categories"},
+	{GenreGames, GenreAll, "Games"},
+	{GenreShopping, GenreAll, "Shopping"},
+	{GenreMagazinesNewspapers, GenreAll, "Magazines & Newspapers"},
+	{GenreEducation, GenreAll, "Education"},
+	{GenreBusiness, GenreAll, "Business"},
+	{GenreFoodDrink, GenreAll, "Food & Drink"},
+	{GenreHealthFitness, GenreAll, "Health & Fitness"},
+	{GenreCatalogs, GenreAll, "Catalogs"},
+	{GenreBooks, GenreAll, "Books"},
+	{GenreMedical, GenreAll, "Medical"},
+	{GenreMusic, GenreAll, "Music"},
+	{GenreNavigation, GenreAll, "Navigation"},
+	{GenreNews, GenreAll, "News"},
+	{GenreLifestyle, GenreAll, "Lifestyle"},
+	{GenreWeather, GenreAll, "Weather"},
+	{GenreProductivity, GenreAll, "Productivity"},
+	{GenreTravel, GenreAll, "Travel"},
+	{GenreEntertainment, GenreAll, "Entertainment"},
+	{GenreSocialNetworking, GenreAll, "Social Networking"},
+	{GenreSports, GenreAll, "Sports"},
+	{GenreReference, GenreAll, "Reference"},
+	{GenreUtilities, GenreAll, "Utilities"},
+	{GenreFinance, GenreAll, "Finance"},
+	{GenrePhotoVideo, GenreAll, "Photo & Video"},
 
 	// Game sub-genres
-	GenreGamesAction:      "Action",
-	GenreGamesAdventure:   "Adventure",
-	GenreGamesArcade:      "Arcade",
-	GenreGamesBoard:       "Board",
-	GenreGamesCard:        "Card",
-	GenreGamesCasino:      "Casino",
-	GenreGamesDice:        "Dice",
-	GenreGamesEducational: "Educational",
-	GenreGamesFamily:      "Family",
-	GenreGamesMusic:       "Music",
-	GenreGamesPuzzle:      "Puzzle",
-	GenreGamesRacing:      "Racing",
-	GenreGamesRolePlaying: "Role-Playing",
-	GenreGamesSimulation:  "Simulation",
-	GenreGamesSports:      "Sports",
-	GenreGamesStrategy:    "Strategy",
-	GenreGamesTrivia:      "Trivia",
-	GenreGamesWord:        "Word",
-
-	// Kids sub-genres
-	GenreKidsLess5: "Kids 5 & Under",
-	GenreKids6To8:  "Kids 6–8",
-	GenreKids9To11: "Kids 9–11",
+	{GenreGamesAction, GenreGames, "Action"},
+	{GenreGamesAdventure, GenreGames, "Adventure"},
+	{GenreGamesArcade, GenreGames, "Arcade"},
+	{GenreGamesBoard, GenreGames, "Board"},
+	{GenreGamesCard, GenreGames, "Card"},
+	{GenreGamesCasino, GenreGames, "Casino"},
+	{GenreGamesDice, GenreGames, "Dice"},
+	{GenreGamesEducational, GenreGames, "Educational"},
+	{GenreGamesFamily, GenreGames, "Family"},
+	{GenreGamesMusic, GenreGames, "Music"},
+	{GenreGamesPuzzle, GenreGames, "Puzzle"},
+	{GenreGamesRacing, GenreGames, "Racing"},
+	{GenreGamesRolePlaying, GenreGames, "Role-Playing"},
+	{GenreGamesSimulation, GenreGames, "Simulation"},
+	{GenreGamesSports, GenreGames, "Sports"},
+	{GenreGamesStrategy, GenreGames, "Strategy"},
+	{GenreGamesTrivia, GenreGames, "Trivia"},
+	{GenreGamesWord, GenreGames, "Word"},
 
 	// Magazines sub-genres
-	GenreMagazinesArtsPhotography:   "Arts & Photography",
-	GenreMagazinesAutomotive:        "Automotive",
-	GenreMagazinesBridesWeddings:    "Brides & Weddings",
-	GenreMagazinesBusinessInvesting: "Business & Investing",
-	GenreMagazinesChildrens:         "Children's Magazines",
-	GenreMagazinesComputingInternet: "Computing & Internet",
-	GenreMagazinesCookingFoodDrink:  "Cooking, Food & Drink",
-	GenreMagazinesCraftsHobbies:     "Crafts & Hobbies",
-	GenreMagazinesElectronicsAudio:  "Electronics & Audio",
-	GenreMagazinesEntertainment:     "Entertainment",
-	GenreMagazinesFashionStyle:      "Fashion & Style",
-	GenreMagazinesFilmsMusic:        "Films & Music",
-	GenreMagazinesHealthWellBeing:   "Health & Well-Being",
-	GenreMagazinesHistory:           "History",
-	GenreMagazinesHomeGarden:        "Home & Garden",
-	GenreMagazinesLiteraryJournals:  "Literary Magazines & Journals",
-	GenreMagazinesMensInterest:      "Men's Interest",
-	GenreMagazinesNewsPolitics:      "News & Politics",
-	GenreMagazinesOutdoorsNature:    "Outdoors & Nature",
-	GenreMagazinesPets:              "Pets",
-	GenreMagazinesProfessionalTrade: "Professional & Trade",
-	GenreMagazinesScience:           "Science",
-	GenreMagazinesSportsLeisure:     "Sports & Leisure",
-	GenreMagazinesTeens:             "Teens",
-	GenreMagazinesTravelRegional:    "Travel & Regional",
-	GenreMagazinesWomensInterest:    "Women's Interest",
+	{GenreMagazinesArtsPhotography, GenreMagazinesNewspapers, "Arts & Photography"},
+	{GenreMagazinesAutomotive, GenreMagazinesNewspapers, "Automotive"},
+	{GenreMagazinesBridesWeddings, GenreMagazinesNewspapers, "Brides & Weddings"},
+	{GenreMagazinesBusinessInvesting, GenreMagazinesNewspapers, "Business & Investing"},
+	{GenreMagazinesChildrens, GenreMagazinesNewspapers, "Children's Magazines"},
+	{GenreMagazinesComputingInternet, GenreMagazinesNewspapers, "Computing & Internet"},
+	{GenreMagazinesCookingFoodDrink, GenreMagazinesNewspapers, "Cooking, Food & Drink"},
+	{GenreMagazinesCraftsHobbies, GenreMagazinesNewspapers, "Crafts & Hobbies"},
+	{GenreMagazinesElectronicsAudio, GenreMagazinesNewspapers, "Electronics & Audio"},
+	{GenreMagazinesEntertainment, GenreMagazinesNewspapers, "Entertainment"},
+	{GenreMagazinesFashionStyle, GenreMagazinesNewspapers, "Fashion & Style"},
+	{GenreMagazinesFilmsMusic, GenreMagazinesNewspapers, "Films & Music"},
+	{GenreMagazinesHealthWellBeing, GenreMagazinesNewspapers, "Health & Well-Being"},
+	{GenreMagazinesHistory, GenreMagazinesNewspapers, "History"},
+	{GenreMagazinesHomeGarden, GenreMagazinesNewspapers, "Home & Garden"},
+	{GenreMagazinesLiteraryJournals, GenreMagazinesNewspapers, "Literary Magazines & Journals"},
+	{GenreMagazinesMensInterest, GenreMagazinesNewspapers, "Men's Interest"},
+	{GenreMagazinesNewsPolitics, GenreMagazinesNewspapers, "News & Politics"},
+	{GenreMagazinesOutdoorsNature, GenreMagazinesNewspapers, "Outdoors & Nature"},
+	{GenreMagazinesPets, GenreMagazinesNewspapers, "Pets"},
+	{GenreMagazinesProfessionalTrade, GenreMagazinesNewspapers, "Professional & Trade"},
+	{GenreMagazinesScience, GenreMagazinesNewspapers, "Science"},
+	{GenreMagazinesSportsLeisure, GenreMagazinesNewspapers, "Sports & Leisure"},
+	{GenreMagazinesTeens, GenreMagazinesNewspapers, "Teens"},
+	{GenreMagazinesTravelRegional, GenreMagazinesNewspapers, "Travel & Regional"},
+	{GenreMagazinesWomensInterest, GenreMagazinesNewspapers, "Women's Interest"},
+}
+
+// macGenreTree lists the built-in Mac App Store genres with their parents.
+//
+//nolint:gochecknoglobals // table is acceptable as a global variable
+var macGenreTree = []genreTreeNode{
+	{GenreMacAll, "", "Mac App Store"},
+	{GenreMacBusiness, GenreMacAll, "Business"},
+	{GenreMacDeveloperTools, GenreMacAll, "Developer Tools"},
+	{GenreMacEducation, GenreMacAll, "Education"},
+	{GenreMacEntertainment, GenreMacAll, "Entertainment"},
+	{GenreMacFinance, GenreMacAll, "Finance"},
+	{GenreMacGames, GenreMacAll, "Games"},
+	{GenreMacHealthFitness, GenreMacAll, "Health & Fitness"},
+	{GenreMacLifestyle, GenreMacAll, "Lifestyle"},
+	{GenreMacMedical, GenreMacAll, "Medical"},
+	{GenreMacMusic, GenreMacAll, "Music"},
+	{GenreMacNews, GenreMacAll, "News"},
+	{GenreMacPhotography, GenreMacAll, "Photography"},
+	{GenreMacProductivity, GenreMacAll, "Productivity"},
+	{GenreMacReference, GenreMacAll, "Reference"},
+	{GenreMacSocialNetworking, GenreMacAll, "Social Networking"},
+	{GenreMacSports, GenreMacAll, "Sports"},
+	{GenreMacTravel, GenreMacAll, "Travel"},
+	{GenreMacUtilities, GenreMacAll, "Utilities"},
+	{GenreMacVideo, GenreMacAll, "Video"},
+	{GenreMacWeather, GenreMacAll, "Weather"},
+	{GenreMacGraphicsDesign, GenreMacAll, "Graphics & Design"},
+}
+
+// platformGenreTree returns the built-in genres charted on platform.
+func platformGenreTree(platform Platform) []genreTreeNode {
+	if platform == PlatformMac {
+		return macGenreTree
+	}
+
+	return genreTree
 }
 
 // Common user agents.
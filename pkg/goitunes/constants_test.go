@@ -45,6 +45,7 @@ func TestGenre_Name(t *testing.T) {
 		{"Business", goitunes.GenreBusiness, "Business"},
 		{"Games Action", goitunes.GenreGamesAction, "Action"},
 		{"Social Networking", goitunes.GenreSocialNetworking, "Social Networking"},
+		{"Mac Developer Tools", goitunes.GenreMacDeveloperTools, "Developer Tools"},
 	}
 
 	for _, tt := range tests {
@@ -85,6 +86,40 @@ func TestGenre_IsValid(t *testing.T) {
 	}
 }
 
+func TestGenre_SupportsPlatform(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		genre    goitunes.Genre
+		platform goitunes.Platform
+		want     bool
+	}{
+		{"All on iPhone", goitunes.GenreAll, goitunes.PlatformIPhone, true},
+		{"All on Mac", goitunes.GenreAll, goitunes.PlatformMac, true},
+		{"Games on iPad", goitunes.GenreGames, goitunes.PlatformIPad, true},
+		{"Games on Mac", goitunes.GenreGames, goitunes.PlatformMac, false},
+		{"Magazines sub-genre on Mac", goitunes.GenreMagazinesPets, goitunes.PlatformMac, false},
+		{"Mac All on Mac", goitunes.GenreMacAll, goitunes.PlatformMac, true},
+		{"Mac Games on Mac", goitunes.GenreMacGames, goitunes.PlatformMac, true},
+		{"Mac Games on macOS alias", goitunes.GenreMacGames, goitunes.Platform("macOS"), true},
+		{"Mac Games on iPhone", goitunes.GenreMacGames, goitunes.PlatformIPhone, false},
+		{"Deprecated Kids on iPhone", goitunes.GenreKidsLess5, goitunes.PlatformIPhone, false},
+		{"Unknown on iPhone", goitunes.Genre("9999"), goitunes.PlatformIPhone, false},
+		{"All on Apple TV", goitunes.GenreAll, goitunes.PlatformAppleTV, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.genre.SupportsPlatform(tt.platform); got != tt.want {
+				t.Errorf("Genre.SupportsPlatform(%s) = %v, want %v", tt.platform, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGenre_CustomGenre(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,98 @@
+package goitunes_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+const artistLookupBody = `{"resultCount":4,"results":[
+	{"wrapperType":"artist","artistType":"Software Artist","artistName":"Test Studio",
+	 "artistLinkUrl":"https://apps.apple.com/us/developer/test-studio/id42","artistId":42},
+	{"wrapperType":"software","kind":"software","trackId":2,"artistId":42},
+	{"wrapperType":"software","kind":"software","trackId":1,"artistId":42},
+	{"wrapperType":"software","kind":"software","trackId":4,"artistId":42}
+]}`
+
+// developerClient answers open API artist lookups and delegates app lookups to a catalog.
+type developerClient struct {
+	catalog *catalogClient
+	limit   string
+}
+
+func (c *developerClient) Do(req *http.Request) (*http.Response, error) {
+	if req.URL.Path != "/lookup" {
+		return c.catalog.Do(req)
+	}
+
+	c.limit = req.URL.Query().Get("limit")
+
+	body := `{"resultCount":0,"results":[]}`
+	if req.URL.Query().Get("id") == "42" {
+		body = artistLookupBody
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestApplications_GetByDeveloper(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &developerClient{catalog: &catalogClient{
+		bundleIDs: map[string]string{"1": "com.test.one", "2": "com.test.two", "4": "com.test.four"},
+		noOffers:  map[string]bool{"4": true},
+	}}
+
+	client, err := goitunes.New("us", goitunes.WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	resp, err := client.Applications().GetByDeveloper(context.Background(), "42", goitunes.WithDeveloperLimit(50))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if httpClient.limit != "50" {
+		t.Errorf("Expected limit 50, got %s", httpClient.limit)
+	}
+
+	if resp.Developer.ID != "42" || resp.Developer.Name != "Test Studio" || !strings.Contains(resp.Developer.URL, "id42") {
+		t.Errorf("Unexpected developer: %+v", resp.Developer)
+	}
+
+	if resp.Developer.AppCount != 3 {
+		t.Errorf("Expected the 3 apps the developer lists, got %d", resp.Developer.AppCount)
+	}
+
+	if len(resp.Applications) != 2 {
+		t.Fatalf("Expected 2 apps sold in the storefront, got %d", len(resp.Applications))
+	}
+
+	if resp.Applications[0].AdamID != "2" || resp.Applications[1].AdamID != "1" {
+		t.Errorf("Expected apps in developer order, got %s %s", resp.Applications[0].AdamID, resp.Applications[1].AdamID)
+	}
+}
+
+func TestApplications_GetByDeveloper_NotFound(t *testing.T) {
+	t.Parallel()
+
+	client, err := goitunes.New("us", goitunes.WithHTTPClient(&developerClient{catalog: &catalogClient{}}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	_, err = client.Applications().GetByDeveloper(context.Background(), "7")
+	if !errors.Is(err, goitunes.ErrDeveloperNotFound) {
+		t.Errorf("Expected ErrDeveloperNotFound, got %v", err)
+	}
+}
@@ -1,23 +1,62 @@
 package goitunes
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/repository"
+	"github.com/truewebber/goitunes/v2/internal/infrastructure/appstore"
+	"github.com/truewebber/goitunes/v2/internal/infrastructure/config"
+	"github.com/truewebber/goitunes/v2/pkg/goitunes/storage"
+)
 
 var (
 	// ErrUnsupportedRegion is returned when the specified region is not supported.
-	ErrUnsupportedRegion = errors.New("unsupported region")
+	ErrUnsupportedRegion = config.ErrUnsupportedRegion
 
-	// ErrNotAuthenticated is returned when authentication is required but not provided.
-	ErrNotAuthenticated = errors.New("not authenticated")
+	// ErrNotAuthenticated is returned when authentication is required but not provided,
+	// or when Apple no longer accepts the session, e.g. after the password token expired.
+	ErrNotAuthenticated = repository.ErrNotAuthenticated
 
 	// ErrInvalidCredentials is returned when credentials are invalid.
-	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrInvalidCredentials = repository.ErrInvalidCredentials
 
 	// ErrApplicationNotFound is returned when the application is not found.
-	ErrApplicationNotFound = errors.New("application not found")
+	ErrApplicationNotFound = repository.ErrApplicationNotFound
+
+	// ErrDeveloperNotFound is returned when a developer has no applications in the storefront.
+	ErrDeveloperNotFound = repository.ErrDeveloperNotFound
+
+	// ErrChartsNotSupported is returned when the App Store has no charts for the
+	// platform set with WithPlatform.
+	ErrChartsNotSupported = appstore.ErrChartsNotSupported
+
+	// ErrGenreNotSupported is returned when a chart genre or age band is not charted
+	// on the client platform, e.g. an iPhone genre on the Mac App Store. See
+	// Genre.SupportsPlatform.
+	ErrGenreNotSupported = appstore.ErrGenreNotSupported
+
+	// ErrNoRankHistory is returned when an application was never recorded in a tracked chart.
+	ErrNoRankHistory = storage.ErrNoRankHistory
+
+	// ErrTemporarilyUnavailable is returned when the App Store cannot serve a request
+	// for now. The request may succeed when retried later.
+	ErrTemporarilyUnavailable = repository.ErrTemporarilyUnavailable
 
 	// ErrPurchaseFailed is returned when purchase operation fails.
-	ErrPurchaseFailed = errors.New("purchase failed")
+	ErrPurchaseFailed = repository.ErrPurchaseFailed
 
 	// ErrInvalidRequest is returned when the request parameters are invalid.
 	ErrInvalidRequest = errors.New("invalid request")
 )
+
+// APIError describes a request the App Store answered with an error. It carries
+// the endpoint, HTTP status code, Apple failureType, customer message, request ID
+// and whether the request may be retried. Use errors.As to inspect it:
+//
+//	var apiErr *goitunes.APIError
+//	if errors.As(err, &apiErr) && apiErr.Retryable {
+//		// try again later
+//	}
+//
+// Known failures also match the sentinels above with errors.Is.
+type APIError = appstore.APIError
@@ -0,0 +1,192 @@
+package goitunes_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+// failingClient answers every request with the same status, headers and body.
+type failingClient struct {
+	header http.Header
+	body   string
+	status int
+	mu     sync.Mutex
+	calls  int
+}
+
+func (c *failingClient) Do(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	c.calls++
+	c.mu.Unlock()
+
+	return &http.Response{
+		StatusCode: c.status,
+		Header:     c.header,
+		Body:       io.NopCloser(strings.NewReader(c.body)),
+		Request:    req,
+	}, nil
+}
+
+// failureBody returns a plist response body reporting an Apple failure.
+func failureBody(failureType, customerMessage string) string {
+	return `<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0"><dict>
+	<key>failureType</key><string>` + failureType + `</string>
+	<key>customerMessage</key><string>` + customerMessage + `</string>
+</dict></plist>`
+}
+
+func TestNew_UnsupportedRegion(t *testing.T) {
+	t.Parallel()
+
+	_, err := goitunes.New("zz")
+	if !errors.Is(err, goitunes.ErrUnsupportedRegion) {
+		t.Errorf("Expected ErrUnsupportedRegion, got %v", err)
+	}
+}
+
+func TestAPIError_StatusCode(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &failingClient{
+		status: http.StatusNotFound,
+		header: http.Header{"X-Apple-Request-Uuid": {"request-1"}},
+	}
+
+	client, err := goitunes.New("us", goitunes.WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	_, err = client.Applications().GetRating(context.Background(), "1")
+	if !errors.Is(err, goitunes.ErrApplicationNotFound) {
+		t.Errorf("Expected ErrApplicationNotFound, got %v", err)
+	}
+
+	var apiErr *goitunes.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected APIError, got %T", err)
+	}
+
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.RequestID != "request-1" || apiErr.Retryable {
+		t.Errorf("Unexpected APIError: %+v", apiErr)
+	}
+
+	if apiErr.Endpoint == "" || strings.Contains(apiErr.Endpoint, "?") {
+		t.Errorf("Expected endpoint without query, got %q", apiErr.Endpoint)
+	}
+}
+
+func TestAPIError_Retryable(t *testing.T) {
+	t.Parallel()
+
+	client, err := goitunes.New("us", goitunes.WithHTTPClient(&failingClient{status: http.StatusServiceUnavailable}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	_, err = client.Charts().GetTop200(context.Background(), "36", goitunes.ChartTypeTopFree)
+
+	var apiErr *goitunes.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected APIError, got %v", err)
+	}
+
+	if !apiErr.Retryable || apiErr.Err != nil {
+		t.Errorf("Expected retryable error without domain error, got %+v", apiErr)
+	}
+}
+
+func TestAPIError_LoginFailure(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &failingClient{
+		status: http.StatusOK,
+		body:   failureBody("-5000", "Your Apple ID or password was entered incorrectly."),
+	}
+
+	client, err := goitunes.New("us",
+		goitunes.WithHTTPClient(httpClient),
+		goitunes.WithAppleID("user@example.com"),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	_, err = client.Auth().Login(context.Background(), "password")
+	if !errors.Is(err, goitunes.ErrInvalidCredentials) {
+		t.Errorf("Expected ErrInvalidCredentials, got %v", err)
+	}
+
+	var apiErr *goitunes.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected APIError, got %T", err)
+	}
+
+	if apiErr.FailureType != "-5000" || !strings.Contains(apiErr.CustomerMessage, "incorrectly") {
+		t.Errorf("Unexpected APIError: %+v", apiErr)
+	}
+
+	if httpClient.calls != 2 {
+		t.Errorf("Expected the login to be retried once, got %d calls", httpClient.calls)
+	}
+}
+
+func TestAPIError_PurchaseFailure(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		failureType string
+		expectedErr error
+		retryable   bool
+	}{
+		{"License not found", "9610", goitunes.ErrPurchaseFailed, false},
+		{"Password token expired", "2034", goitunes.ErrNotAuthenticated, false},
+		{"Unknown error", "5002", goitunes.ErrPurchaseFailed, true},
+		{"Temporarily unavailable", "2059", goitunes.ErrTemporarilyUnavailable, true},
+		{"Unmapped failure", "1234", goitunes.ErrPurchaseFailed, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := &failingClient{status: http.StatusOK, body: failureBody(tt.failureType, "Failed")}
+
+			client, err := goitunes.New("us",
+				goitunes.WithHTTPClient(httpClient),
+				goitunes.WithCredentials("user@example.com", "token", "12345"),
+				goitunes.WithKbsync("kbsync"),
+			)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			_, err = client.Purchase().Buy(context.Background(), "1", 1)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Errorf("Expected %v, got %v", tt.expectedErr, err)
+			}
+
+			var apiErr *goitunes.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("Expected APIError, got %T", err)
+			}
+
+			if errors.Is(err, goitunes.ErrApplicationNotFound) {
+				t.Errorf("Expected failure %s not to match ErrApplicationNotFound", tt.failureType)
+			}
+
+			if apiErr.FailureType != tt.failureType || apiErr.Retryable != tt.retryable {
+				t.Errorf("Unexpected APIError: %+v", apiErr)
+			}
+		})
+	}
+}
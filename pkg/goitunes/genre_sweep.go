@@ -0,0 +1,212 @@
+package goitunes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+)
+
+// DefaultGenreConcurrency is the default number of genre charts fetched concurrently by GetAllGenres.
+const DefaultGenreConcurrency = 4
+
+// ChartResult holds the outcome of fetching a single chart.
+type ChartResult struct {
+	Value *dto.GetTopChartsResponse
+	Err   error
+}
+
+// GenreSweep holds the top 200 charts of every known genre and Kids age band.
+type GenreSweep struct {
+	Genres   map[Genre]ChartResult
+	AgeBands map[AgeBand]ChartResult // Kids charts of GenreAll limited to an age band
+}
+
+// SweepProgress reports a finished chart of a GetAllGenres call.
+// Exactly one of Genre and AgeBand is set.
+type SweepProgress struct {
+	Genre   Genre
+	AgeBand AgeBand
+	Err     error
+	Done    int
+	Total   int
+}
+
+// SweepOption is a functional option for GetAllGenres.
+type SweepOption func(*sweepConfig)
+
+// sweepConfig holds the options of a GetAllGenres call.
+type sweepConfig struct {
+	progress     func(SweepProgress)
+	chartOptions []Top200Option
+	concurrency  int
+}
+
+// WithSweepConcurrency bounds the number of charts fetched at once.
+// Non-positive values use DefaultGenreConcurrency.
+func WithSweepConcurrency(workers int) SweepOption {
+	return func(cfg *sweepConfig) {
+		if workers > 0 {
+			cfg.concurrency = workers
+		}
+	}
+}
+
+// WithSweepProgress calls fn after every chart, successful or not.
+// Calls are serialised, so fn does not need to be safe for concurrent use.
+func WithSweepProgress(fn func(SweepProgress)) SweepOption {
+	return func(cfg *sweepConfig) {
+		cfg.progress = fn
+	}
+}
+
+// WithSweepChartOptions applies Top200Options such as WithRange to every chart.
+func WithSweepChartOptions(options ...Top200Option) SweepOption {
+	return func(cfg *sweepConfig) {
+		cfg.chartOptions = append(cfg.chartOptions, options...)
+	}
+}
+
+// GetAllGenres retrieves the top 200 chart of every Genre constant of the client
+// platform, sub-genres included, and of every Kids age band. Mac clients sweep
+// the GenreMac genres and have no age bands. A failing chart does not stop the others;
+// its error is kept in the results. The returned error is only set when ctx ends
+// before all charts were fetched.
+func (s *ChartService) GetAllGenres(
+	ctx context.Context,
+	chartType ChartType,
+	options ...SweepOption,
+) (*GenreSweep, error) {
+	cfg := sweepConfig{concurrency: DefaultGenreConcurrency}
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	tree := platformGenreTree(s.platform)
+
+	bands := ageBands
+	if s.platform == PlatformMac {
+		bands = nil
+	}
+
+	sweep := &GenreSweep{
+		Genres:   make(map[Genre]ChartResult, len(tree)),
+		AgeBands: make(map[AgeBand]ChartResult, len(bands)),
+	}
+
+	var (
+		mu   sync.Mutex
+		done int
+	)
+
+	total := len(tree) + len(bands)
+
+	// record stores a result and reports progress under one lock, so progress
+	// callbacks see Done increase by one at a time.
+	record := func(genre Genre, band AgeBand, result ChartResult) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if band != "" {
+			sweep.AgeBands[band] = result
+		} else {
+			sweep.Genres[genre] = result
+		}
+
+		done++
+
+		if cfg.progress != nil {
+			cfg.progress(SweepProgress{Genre: genre, AgeBand: band, Err: result.Err, Done: done, Total: total})
+		}
+	}
+
+	fetch := func(genre Genre, opts []Top200Option) ChartResult {
+		if err := ctx.Err(); err != nil {
+			return ChartResult{Err: err}
+		}
+
+		resp, err := s.GetTop200(ctx, genre, chartType, opts...)
+
+		return ChartResult{Value: resp, Err: err}
+	}
+
+	group := new(errgroup.Group)
+	group.SetLimit(cfg.concurrency)
+
+	for _, node := range tree {
+		group.Go(func() error {
+			record(node.genre, "", fetch(node.genre, cfg.chartOptions))
+
+			return nil
+		})
+	}
+
+	for _, band := range bands {
+		opts := append([]Top200Option{WithAgeBand(band)}, cfg.chartOptions...)
+
+		group.Go(func() error {
+			record("", band, fetch(GenreAll, opts))
+
+			return nil
+		})
+	}
+
+	//nolint:errcheck // goroutines never return an error, failures are kept per chart
+	_ = group.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return sweep, fmt.Errorf("failed to get all genre charts: %w", err)
+	}
+
+	return sweep, nil
+}
+
+// Items returns the chart items of the genres that succeeded.
+func (s *GenreSweep) Items() map[Genre][]dto.ChartItemDTO {
+	items := make(map[Genre][]dto.ChartItemDTO, len(s.Genres))
+
+	for genre, result := range s.Genres {
+		if result.Err == nil {
+			items[genre] = result.Value.Items
+		}
+	}
+
+	return items
+}
+
+// AgeBandItems returns the chart items of the Kids age bands that succeeded.
+func (s *GenreSweep) AgeBandItems() map[AgeBand][]dto.ChartItemDTO {
+	items := make(map[AgeBand][]dto.ChartItemDTO, len(s.AgeBands))
+
+	for band, result := range s.AgeBands {
+		if result.Err == nil {
+			items[band] = result.Value.Items
+		}
+	}
+
+	return items
+}
+
+// Err joins the errors of all failed charts, or returns nil if every chart succeeded.
+func (s *GenreSweep) Err() error {
+	var errs []error
+
+	for _, node := range slices.Concat(genreTree, macGenreTree) {
+		if result, ok := s.Genres[node.genre]; ok && result.Err != nil {
+			errs = append(errs, fmt.Errorf("genre %s: %w", node.genre, result.Err))
+		}
+	}
+
+	for _, band := range ageBands {
+		if result, ok := s.AgeBands[band]; ok && result.Err != nil {
+			errs = append(errs, fmt.Errorf("age band %s: %w", band, result.Err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
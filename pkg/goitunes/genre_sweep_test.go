@@ -0,0 +1,189 @@
+package goitunes_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+// sweepClient answers chart requests with an empty chart and fails one genre.
+type sweepClient struct {
+	mu        sync.Mutex
+	genres    map[string]int
+	ageBands  map[string]string
+	failGenre string
+}
+
+func (c *sweepClient) Do(req *http.Request) (*http.Response, error) {
+	query := req.URL.Query()
+	genreID := query.Get("genreId")
+
+	c.mu.Lock()
+	c.genres[genreID]++
+
+	if band := query.Get("ageBandId"); band != "" {
+		c.ageBands[band] = genreID
+	}
+	c.mu.Unlock()
+
+	if genreID == c.failGenre {
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(strings.NewReader("")),
+			Request:    req,
+		}, nil
+	}
+
+	body := `{"pageData":{"segmentedControl":{"segments":[{"pageData":{"selectedChart":{"adamIds":[]}}}]}}}`
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestChartService_GetAllGenres(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &sweepClient{
+		genres:    make(map[string]int),
+		ageBands:  make(map[string]string),
+		failGenre: goitunes.GenreBusiness.String(),
+	}
+
+	client, err := goitunes.New("us", goitunes.WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var (
+		progress []goitunes.SweepProgress
+		inFlight bool
+	)
+
+	sweep, err := client.Charts().GetAllGenres(context.Background(), goitunes.ChartTypeTopFree,
+		goitunes.WithSweepConcurrency(3),
+		goitunes.WithSweepProgress(func(p goitunes.SweepProgress) {
+			if inFlight {
+				t.Error("Expected progress callbacks to be serialised")
+			}
+
+			inFlight = true
+			progress = append(progress, p)
+			inFlight = false
+		}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []goitunes.Genre{
+		goitunes.GenreAll, goitunes.GenreGames, goitunes.GenreGamesPuzzle, goitunes.GenreMagazinesPets,
+	}
+
+	for _, genre := range expected {
+		if result, ok := sweep.Genres[genre]; !ok || result.Err != nil {
+			t.Errorf("Expected a chart for genre %s, got %+v", genre, result)
+		}
+	}
+
+	if len(sweep.AgeBands) != 4 || httpClient.ageBands[goitunes.AgeBandSixToEight.String()] != goitunes.GenreAll.String() {
+		t.Errorf("Expected age band charts of GenreAll, got %v", httpClient.ageBands)
+	}
+
+	if httpClient.genres["KIDS_LESS_5"] != 0 {
+		t.Error("Expected age bands not to be requested as genres")
+	}
+
+	if result := sweep.Genres[goitunes.GenreBusiness]; result.Err == nil {
+		t.Error("Expected the Business chart to fail")
+	}
+
+	if _, ok := sweep.Items()[goitunes.GenreBusiness]; ok {
+		t.Error("Expected failed genres to be left out of the items")
+	}
+
+	if len(sweep.Items()) != len(sweep.Genres)-1 || len(sweep.AgeBandItems()) != 4 {
+		t.Errorf("Unexpected items of %d genres and %d age bands", len(sweep.Items()), len(sweep.AgeBandItems()))
+	}
+
+	var apiErr *goitunes.APIError
+	if sweepErr := sweep.Err(); !errors.As(sweepErr, &apiErr) || !strings.Contains(sweepErr.Error(), "genre 6000") {
+		t.Errorf("Expected the Business error, got %v", sweepErr)
+	}
+
+	total := len(sweep.Genres) + len(sweep.AgeBands)
+	if len(progress) != total || progress[total-1].Done != total || progress[0].Total != total {
+		t.Errorf("Expected %d progress reports, got %d", total, len(progress))
+	}
+}
+
+func TestChartService_GetAllGenres_Canceled(t *testing.T) {
+	t.Parallel()
+
+	client, err := goitunes.New("us", goitunes.WithHTTPClient(&sweepClient{
+		genres:   make(map[string]int),
+		ageBands: make(map[string]string),
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	sweep, err := client.Charts().GetAllGenres(ctx, goitunes.ChartTypeTopPaid)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+
+	if len(sweep.Items()) != 0 || !errors.Is(sweep.Err(), context.Canceled) {
+		t.Error("Expected every chart to fail with the context error")
+	}
+}
+
+func TestChartService_GetAllGenres_Mac(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &sweepClient{
+		genres:   make(map[string]int),
+		ageBands: make(map[string]string),
+	}
+
+	client, err := goitunes.New("us", goitunes.WithHTTPClient(httpClient), goitunes.WithPlatform(goitunes.PlatformMac))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	sweep, err := client.Charts().GetAllGenres(context.Background(), goitunes.ChartTypeTopFree)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err = sweep.Err(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, ok := sweep.Genres[goitunes.GenreMacDeveloperTools]; !ok {
+		t.Error("Expected a chart for the Mac Developer Tools genre")
+	}
+
+	if _, ok := sweep.Genres[goitunes.GenreGames]; ok {
+		t.Error("Expected no chart for the iPhone Games genre")
+	}
+
+	if len(sweep.AgeBands) != 0 || len(httpClient.ageBands) != 0 {
+		t.Errorf("Expected no age band charts on Mac, got %v", httpClient.ageBands)
+	}
+
+	if httpClient.genres["39"] != 1 || httpClient.genres["36"] != 0 {
+		t.Errorf("Expected one chart of the Mac root genre, got %v", httpClient.genres)
+	}
+}
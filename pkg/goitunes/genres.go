@@ -0,0 +1,141 @@
+package goitunes
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+)
+
+// GenreCatalog is the App Store genre tree with parent/child relationships,
+// e.g. Games → Puzzle. It is JSON-serialisable, so it can be cached in a file;
+// see GenreService.Refresh and LoadGenreCatalog.
+type GenreCatalog = dto.GenreCatalogDTO
+
+// GenreNode is a genre of a GenreCatalog.
+type GenreNode = dto.GenreNodeDTO
+
+// AgeBand represents an age band of the Kids charts. Age bands are not genres:
+// pass them to GetTop200 with WithAgeBand.
+type AgeBand string
+
+// App Store age bands.
+const (
+	AgeBandKids         AgeBand = "KIDS"         // All Kids age bands
+	AgeBandFiveAndUnder AgeBand = "KIDS_LESS_5"  // Ages 5 & Under
+	AgeBandSixToEight   AgeBand = "KIDS_6_TO_8"  // Ages 6–8
+	AgeBandNineToEleven AgeBand = "KIDS_9_TO_11" // Ages 9–11
+)
+
+// ageBands lists the age bands from the widest to the youngest.
+//
+//nolint:gochecknoglobals // list is acceptable as a global variable
+var ageBands = []AgeBand{AgeBandKids, AgeBandFiveAndUnder, AgeBandSixToEight, AgeBandNineToEleven}
+
+// ageBandNames maps age bands to their human-readable names.
+//
+//nolint:gochecknoglobals // map is acceptable as a global variable
+var ageBandNames = map[AgeBand]string{
+	AgeBandKids:         "Kids",
+	AgeBandFiveAndUnder: "Kids 5 & Under",
+	AgeBandSixToEight:   "Kids 6–8",
+	AgeBandNineToEleven: "Kids 9–11",
+}
+
+// String returns the age band identifier.
+func (a AgeBand) String() string {
+	return string(a)
+}
+
+// IsValid checks if the age band is a known age band.
+func (a AgeBand) IsValid() bool {
+	_, exists := ageBandNames[a]
+
+	return exists
+}
+
+// Name returns the human-readable name of the age band.
+func (a AgeBand) Name() string {
+	return ageBandNames[a]
+}
+
+// builtinGenreCatalog is the genre tree of the genre constants.
+//
+//nolint:gochecknoglobals // catalog is acceptable as a global variable
+var builtinGenreCatalog = newBuiltinGenreCatalog()
+
+// newBuiltinGenreCatalog builds a genre catalog from genreTree and macGenreTree.
+func newBuiltinGenreCatalog() *GenreCatalog {
+	genres := make([]GenreNode, 0, len(genreTree)+len(macGenreTree))
+	for _, genre := range slices.Concat(genreTree, macGenreTree) {
+		genres = append(genres, GenreNode{
+			ID:       genre.genre.String(),
+			Name:     genre.name,
+			ParentID: genre.parent.String(),
+		})
+	}
+
+	return &GenreCatalog{Language: "en", Genres: genres}
+}
+
+// DefaultGenreCatalog returns the built-in genre tree with English names.
+// It only knows the genres of the Genre constants; use GenreService.Catalog
+// or GenreService.Refresh for the current tree of a storefront.
+func DefaultGenreCatalog() *GenreCatalog {
+	catalog := *builtinGenreCatalog
+	catalog.Genres = slices.Clone(builtinGenreCatalog.Genres)
+
+	return &catalog
+}
+
+// LoadGenreCatalog reads a genre catalog cached with GenreService.Refresh or SaveGenreCatalog.
+func LoadGenreCatalog(path string) (*GenreCatalog, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read genre catalog: %w", err)
+	}
+
+	var catalog GenreCatalog
+	if err = json.Unmarshal(data, &catalog); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal genre catalog: %w", err)
+	}
+
+	return &catalog, nil
+}
+
+// SaveGenreCatalog writes catalog to path as JSON. The file is replaced atomically,
+// so concurrent readers never see a partially written catalog.
+func SaveGenreCatalog(path string, catalog *GenreCatalog) error {
+	data, err := json.MarshalIndent(catalog, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal genre catalog: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create genre catalog file: %w", err)
+	}
+
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+
+		return fmt.Errorf("failed to write genre catalog: %w", err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write genre catalog: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace genre catalog: %w", err)
+	}
+
+	return nil
+}
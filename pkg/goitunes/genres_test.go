@@ -0,0 +1,200 @@
+package goitunes_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+// genresClient answers genre service requests with a small genre tree under the requested root.
+type genresClient struct {
+	mu      sync.Mutex
+	queries []string
+}
+
+func (c *genresClient) Do(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	c.queries = append(c.queries, req.URL.RawQuery)
+	c.mu.Unlock()
+
+	body := `{"36":{"id":"36","name":"App Store","subgenres":{
+		"6014":{"id":"6014","name":"Spiele","subgenres":{
+			"7012":{"id":"7012","name":"Rätsel"},
+			"7001":{"id":"7001","name":"Action"}}},
+		"6000":{"id":"6000","name":"Wirtschaft"},
+		"6027":{"id":"6027","name":"Grafik und Design"}}}}`
+	if root := req.URL.Query().Get("id"); root != "36" {
+		body = strings.ReplaceAll(body, `"36"`, `"`+root+`"`)
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestGenre_Hierarchy(t *testing.T) {
+	t.Parallel()
+
+	parent, ok := goitunes.GenreGamesPuzzle.Parent()
+	if !ok || parent != goitunes.GenreGames {
+		t.Errorf("Expected parent %s, got %s", goitunes.GenreGames, parent)
+	}
+
+	parent, ok = goitunes.GenreMagazinesPets.Parent()
+	if !ok || parent != goitunes.GenreMagazinesNewspapers {
+		t.Errorf("Expected parent %s, got %s", goitunes.GenreMagazinesNewspapers, parent)
+	}
+
+	if _, ok = goitunes.GenreAll.Parent(); ok {
+		t.Error("Expected GenreAll to have no parent")
+	}
+
+	subgenres := goitunes.GenreGames.Subgenres()
+	if len(subgenres) != 18 || subgenres[0] != goitunes.GenreGamesAction {
+		t.Errorf("Expected 18 Games subgenres starting with Action, got %v", subgenres)
+	}
+
+	for _, genre := range goitunes.GenreAll.Subgenres() {
+		if genre == goitunes.GenreKids {
+			t.Error("Expected Kids not to be a genre")
+		}
+	}
+
+	path := goitunes.DefaultGenreCatalog().Path(goitunes.GenreGamesPuzzle.String())
+	if len(path) != 3 || path[0].Name != "All Categories" || path[1].Name != "Games" || path[2].Name != "Puzzle" {
+		t.Errorf("Unexpected path %v", path)
+	}
+}
+
+func TestAgeBand(t *testing.T) {
+	t.Parallel()
+
+	if !goitunes.AgeBandSixToEight.IsValid() || goitunes.AgeBandSixToEight.Name() != "Kids 6–8" {
+		t.Errorf("Unexpected age band %s: %s", goitunes.AgeBandSixToEight, goitunes.AgeBandSixToEight.Name())
+	}
+
+	if goitunes.AgeBand("6014").IsValid() {
+		t.Error("Expected a genre not to be an age band")
+	}
+
+	if goitunes.GenreKidsLess5.String() != goitunes.AgeBandFiveAndUnder.String() {
+		t.Error("Expected GenreKidsLess5 to match AgeBandFiveAndUnder")
+	}
+}
+
+func TestGenreService_Refresh(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &genresClient{}
+
+	client, err := goitunes.New("de", goitunes.WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "genres.json")
+
+	catalog, err := client.Genres().Refresh(context.Background(), path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !strings.Contains(httpClient.queries[0], "id=36") || !strings.Contains(httpClient.queries[0], "cc=de") {
+		t.Errorf("Unexpected query %s", httpClient.queries[0])
+	}
+
+	if catalog.Region != "de" || catalog.Language != "de-DE" || catalog.RefreshedAt.IsZero() {
+		t.Errorf("Unexpected catalog metadata %s %s %v", catalog.Region, catalog.Language, catalog.RefreshedAt)
+	}
+
+	var ids []string
+	for _, genre := range catalog.Genres {
+		ids = append(ids, genre.ID)
+	}
+
+	if strings.Join(ids, ",") != "36,6000,6014,7001,7012,6027" {
+		t.Errorf("Expected parents before subgenres ordered by ID, got %v", ids)
+	}
+
+	if catalog.Name("7012") != "Rätsel" {
+		t.Errorf("Expected localized name, got %q", catalog.Name("7012"))
+	}
+
+	if parent, ok := catalog.Parent("7012"); !ok || parent.ID != "6014" {
+		t.Errorf("Expected parent 6014, got %v", parent)
+	}
+
+	loaded, err := goitunes.LoadGenreCatalog(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(loaded.Genres) != len(catalog.Genres) || loaded.Name("6027") != "Grafik und Design" {
+		t.Errorf("Expected cached catalog to match, got %v", loaded.Genres)
+	}
+
+	cached, err := client.Genres().Cached(context.Background(), path, time.Hour)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(httpClient.queries) != 1 || len(cached.Subgenres("6014")) != 2 {
+		t.Errorf("Expected the cached catalog without a request, got %d requests", len(httpClient.queries))
+	}
+
+	if _, err = client.Genres().Cached(context.Background(), path, 0); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(httpClient.queries) != 2 {
+		t.Errorf("Expected an expired cache to be refreshed, got %d requests", len(httpClient.queries))
+	}
+}
+
+func TestGenreService_Cached_Platform(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &genresClient{}
+	path := filepath.Join(t.TempDir(), "genres.json")
+
+	iphone, err := goitunes.New("de", goitunes.WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	catalog, err := iphone.Genres().Refresh(context.Background(), path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if catalog.Platform != "iphone" {
+		t.Errorf("Expected platform iphone, got %q", catalog.Platform)
+	}
+
+	mac, err := goitunes.New("de", goitunes.WithHTTPClient(httpClient), goitunes.WithPlatform(goitunes.PlatformMac))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	catalog, err = mac.Genres().Cached(context.Background(), path, time.Hour)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(httpClient.queries) != 2 || !strings.Contains(httpClient.queries[1], "id=39") {
+		t.Errorf("Expected the iPhone catalog to be refreshed for the Mac, got queries %v", httpClient.queries)
+	}
+
+	if catalog.Platform != "mac" {
+		t.Errorf("Expected platform mac, got %q", catalog.Platform)
+	}
+}
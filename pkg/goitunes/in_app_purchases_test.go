@@ -0,0 +1,74 @@
+package goitunes_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+const inAppPurchasesBody = `{"storePlatformData":{"product-dv":{"results":{"1":{
+	"id":"1","bundleId":"com.test.app","name":"Test",
+	"topInAppPurchases":[
+		{"adamId":"11","name":"Handvoll Edelsteine","kind":"consumable","offers":[{"priceFormatted":"1,99 €","price":1.99}]},
+		{"adamId":"12","name":"Premium","kind":"autoRenewableSubscription","offers":[{"priceFormatted":"1.099,00 €"}]},
+		{"id":"13","name":"Werbefrei","kind":"somethingNew","priceFormatted":"4,49 €"},
+		{"adamId":"14","name":"Sternenpaket","kind":"nonConsumable","offers":[{"price":4.99}]}
+	]
+}}}}}`
+
+func TestApplications_GetInAppPurchases(t *testing.T) {
+	t.Parallel()
+
+	client, err := goitunes.New("de", goitunes.WithHTTPClient(&staticClient{body: inAppPurchasesBody}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	resp, err := client.Applications().GetInAppPurchases(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if resp.AdamID != "1" || resp.Storefront != "de" {
+		t.Errorf("Unexpected application or storefront: %s %s", resp.AdamID, resp.Storefront)
+	}
+
+	expected := []struct {
+		adamID   string
+		kind     string
+		currency string
+		price    float64
+	}{
+		{"11", "consumable", "EUR", 1.99},
+		{"12", "auto-renewable-subscription", "EUR", 1099},
+		{"13", "unknown", "EUR", 4.49},
+		{"14", "non-consumable", "EUR", 4.99}, // No formatted price, the numeric price is kept
+	}
+
+	if len(resp.InAppPurchases) != len(expected) {
+		t.Fatalf("Expected %d in-app purchases, got %d", len(expected), len(resp.InAppPurchases))
+	}
+
+	for i, want := range expected {
+		got := resp.InAppPurchases[i]
+
+		if got.AdamID != want.adamID || got.Type != want.kind || got.Currency != want.currency || got.Price != want.price {
+			t.Errorf("Expected %+v, got %+v", want, got)
+		}
+	}
+}
+
+func TestApplications_GetInAppPurchases_EmptyID(t *testing.T) {
+	t.Parallel()
+
+	client, err := goitunes.New("us", goitunes.WithHTTPClient(&staticClient{body: inAppPurchasesBody}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err = client.Applications().GetInAppPurchases(context.Background(), ""); !errors.Is(err, goitunes.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest, got %v", err)
+	}
+}
@@ -0,0 +1,23 @@
+package goitunes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/repository"
+	"github.com/truewebber/goitunes/v2/internal/domain/valueobject"
+)
+
+// ContextWithLanguage returns a context that requests metadata in the language tag
+// for every call made with it, overriding WithLanguage and the store default:
+//
+//	ctx, err := goitunes.ContextWithLanguage(ctx, "ja-JP")
+//	apps, err := client.Applications().GetByAdamID(ctx, "284882215")
+func ContextWithLanguage(ctx context.Context, tag string) (context.Context, error) {
+	lang, err := valueobject.NewLanguage(tag)
+	if err != nil {
+		return ctx, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+
+	return repository.ContextWithLanguage(ctx, lang), nil
+}
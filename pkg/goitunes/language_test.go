@@ -0,0 +1,122 @@
+package goitunes_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+// languageLookupClient answers lookup requests with a name in the requested language.
+type languageLookupClient struct {
+	mu              sync.Mutex
+	locales         []string
+	acceptLanguages []string
+}
+
+func (c *languageLookupClient) Do(req *http.Request) (*http.Response, error) {
+	locale := req.URL.Query().Get("l")
+
+	c.mu.Lock()
+	c.locales = append(c.locales, locale)
+	c.acceptLanguages = append(c.acceptLanguages, req.Header.Get("Accept-Language"))
+	c.mu.Unlock()
+
+	body := `{"results":{"1":{"id":"1","bundleId":"com.test.app","name":"Test ` + locale + `"}}}`
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestClient_Language(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		region           string
+		opts             []goitunes.Option
+		contextLanguage  string
+		expectedLocale   string
+		expectedLanguage string
+	}{
+		{"Store default", "jp", nil, "", "ja_jp", "ja-JP"},
+		{"Store default of another region", "de", nil, "", "de_de", "de-DE"},
+		{"Client option", "jp", []goitunes.Option{goitunes.WithLanguage("en_us")}, "", "en_us", "en-US"},
+		{"Context override", "jp", []goitunes.Option{goitunes.WithLanguage("en")}, "de-AT", "de_at", "de-AT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := &languageLookupClient{}
+
+			client, err := goitunes.New(tt.region, append(tt.opts, goitunes.WithHTTPClient(httpClient))...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			ctx := context.Background()
+			if tt.contextLanguage != "" {
+				ctx, err = goitunes.ContextWithLanguage(ctx, tt.contextLanguage)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			}
+
+			apps, err := client.Applications().GetByBundleID(ctx, "com.test.app")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(httpClient.locales) != 1 || httpClient.locales[0] != tt.expectedLocale {
+				t.Errorf("Expected l=%s, got %v", tt.expectedLocale, httpClient.locales)
+			}
+
+			if httpClient.acceptLanguages[0] != tt.expectedLanguage {
+				t.Errorf("Expected Accept-Language %s, got %s", tt.expectedLanguage, httpClient.acceptLanguages[0])
+			}
+
+			if len(apps) != 1 {
+				t.Fatalf("Expected 1 app, got %d", len(apps))
+			}
+
+			if apps[0].Locale != tt.expectedLanguage {
+				t.Errorf("Expected locale %s, got %s", tt.expectedLanguage, apps[0].Locale)
+			}
+
+			if apps[0].Name != "Test "+tt.expectedLocale {
+				t.Errorf("Expected localised name, got %s", apps[0].Name)
+			}
+		})
+	}
+}
+
+func TestWithLanguage_Invalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := goitunes.New("us", goitunes.WithLanguage("english")); !errors.Is(err, goitunes.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest, got %v", err)
+	}
+
+	if _, err := goitunes.ContextWithLanguage(context.Background(), ""); !errors.Is(err, goitunes.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest, got %v", err)
+	}
+
+	client, err := goitunes.New("gb")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if client.Language() != "en-GB" {
+		t.Errorf("Expected en-GB, got %s", client.Language())
+	}
+}
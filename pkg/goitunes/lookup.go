@@ -0,0 +1,60 @@
+package goitunes
+
+import "github.com/truewebber/goitunes/v2/internal/application/dto"
+
+// LookupStatus describes the outcome of looking up a single identifier.
+type LookupStatus = dto.LookupStatus
+
+// LookupResult pairs one requested identifier with its outcome.
+// App is set for found and not available applications.
+type LookupResult = dto.LookupResultDTO
+
+// Lookup statuses.
+const (
+	LookupStatusFound        = dto.LookupStatusFound
+	LookupStatusNotFound     = dto.LookupStatusNotFound
+	LookupStatusNotAvailable = dto.LookupStatusNotAvailable
+	LookupStatusFailed       = dto.LookupStatusFailed
+)
+
+// LookupResults holds one result per requested Adam ID or Bundle ID, in request order.
+type LookupResults []LookupResult
+
+// Get returns the result of the identifier key, as it was requested.
+func (r LookupResults) Get(key string) (LookupResult, bool) {
+	for _, result := range r {
+		if result.ID == key {
+			return result, true
+		}
+	}
+
+	return LookupResult{}, false
+}
+
+// Apps returns the applications that were found, including those not sold
+// in the storefront, in request order.
+func (r LookupResults) Apps() []dto.ApplicationDTO {
+	apps := make([]dto.ApplicationDTO, 0, len(r))
+
+	for _, result := range r {
+		if result.App != nil {
+			apps = append(apps, *result.App)
+		}
+	}
+
+	return apps
+}
+
+// Missing returns the requested identifiers without an application: those
+// not found and those whose lookup failed.
+func (r LookupResults) Missing() []string {
+	missing := make([]string, 0)
+
+	for _, result := range r {
+		if result.App == nil {
+			missing = append(missing, result.ID)
+		}
+	}
+
+	return missing
+}
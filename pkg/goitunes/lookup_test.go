@@ -0,0 +1,149 @@
+package goitunes_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+// catalogClient answers lookup requests from a fixed catalog of bundle IDs by Adam ID.
+// Apps without offers are not sold in the storefront.
+type catalogClient struct {
+	bundleIDs map[string]string
+	noOffers  map[string]bool
+}
+
+func (c *catalogClient) Do(req *http.Request) (*http.Response, error) {
+	query := req.URL.Query()
+
+	items := make([]string, 0)
+
+	for adamID, bundleID := range c.bundleIDs {
+		requested := slices.Contains(strings.Split(query.Get("id"), ","), adamID) ||
+			slices.ContainsFunc(strings.Split(query.Get("bundleId"), ","), func(id string) bool {
+				return strings.EqualFold(id, bundleID)
+			})
+		if !requested {
+			continue
+		}
+
+		offers := `[{"price":0}]`
+		if c.noOffers[adamID] {
+			offers = `[]`
+		}
+
+		items = append(items, `"`+adamID+`":{"id":"`+adamID+`","bundleId":"`+bundleID+
+			`","name":"App `+adamID+`","offers":`+offers+`}`)
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`{"results":{` + strings.Join(items, ",") + `}}`)),
+		Request:    req,
+	}, nil
+}
+
+func newCatalogClient(t *testing.T) *goitunes.Client {
+	t.Helper()
+
+	httpClient := &catalogClient{
+		bundleIDs: map[string]string{
+			"1": "com.test.one",
+			"2": "com.test.two",
+			"3": "com.test.three",
+			"4": "com.test.four",
+			"5": "com.test.five",
+		},
+		noOffers: map[string]bool{"4": true},
+	}
+
+	client, err := goitunes.New("us", goitunes.WithHTTPClient(httpClient), goitunes.WithLookupBatchSize(2))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	return client
+}
+
+func TestApplications_GetByAdamID_Order(t *testing.T) {
+	t.Parallel()
+
+	client := newCatalogClient(t)
+	requested := []string{"5", "3", "1", "2"}
+
+	// Lookup responses are maps, so repeat to catch ordering that depends on map iteration.
+	for range 20 {
+		apps, err := client.Applications().GetByAdamID(context.Background(), requested...)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		got := make([]string, 0, len(apps))
+		for _, app := range apps {
+			got = append(got, app.AdamID)
+		}
+
+		if !slices.Equal(got, requested) {
+			t.Fatalf("Expected apps in order %v, got %v", requested, got)
+		}
+	}
+}
+
+func TestApplications_LookupByBundleID(t *testing.T) {
+	t.Parallel()
+
+	client := newCatalogClient(t)
+
+	results, err := client.Applications().LookupByBundleID(context.Background(),
+		"com.test.three", "com.missing", "COM.TEST.ONE", "com.test.four")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []struct {
+		key    string
+		adamID string
+		status goitunes.LookupStatus
+	}{
+		{"com.test.three", "3", goitunes.LookupStatusFound},
+		{"com.missing", "", goitunes.LookupStatusNotFound},
+		{"COM.TEST.ONE", "1", goitunes.LookupStatusFound},
+		{"com.test.four", "4", goitunes.LookupStatusNotAvailable},
+	}
+
+	if len(results) != len(expected) {
+		t.Fatalf("Expected %d results, got %d", len(expected), len(results))
+	}
+
+	for i, want := range expected {
+		result := results[i]
+		if result.ID != want.key || result.Status != want.status {
+			t.Errorf("Result %d: expected %s %s, got %s %s", i, want.key, want.status, result.ID, result.Status)
+		}
+
+		if want.adamID != "" && (result.App == nil || result.App.AdamID != want.adamID) {
+			t.Errorf("Result %d: expected app %s, got %v", i, want.adamID, result.App)
+		}
+	}
+
+	if result, ok := results.Get("COM.TEST.ONE"); !ok || result.App.AdamID != "1" {
+		t.Errorf("Expected Get to find the requested key, got %v %v", result, ok)
+	}
+
+	if _, ok := results.Get("com.other"); ok {
+		t.Error("Expected Get to miss a key that was not requested")
+	}
+
+	if apps := results.Apps(); len(apps) != 3 || apps[0].AdamID != "3" || apps[2].AdamID != "4" {
+		t.Errorf("Unexpected apps: %v", apps)
+	}
+
+	if missing := results.Missing(); !slices.Equal(missing, []string{"com.missing"}) {
+		t.Errorf("Expected com.missing to be missing, got %v", missing)
+	}
+}
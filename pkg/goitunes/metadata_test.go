@@ -0,0 +1,124 @@
+package goitunes_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+const richLookupBody = `{"results":{"1":{
+	"id":"1","bundleId":"com.test.app","name":"Test",
+	"genres":[
+		{"genreId":"6014","name":"Games","url":"https://apps.apple.com/genre/6014"},
+		{"genreId":"7003","name":"Casual"}
+	],
+	"offers":[
+		{"type":"buy","price":1.99,"priceFormatted":"$1.99","buyParams":"productType=C&price=1990",
+		 "version":{"display":"2.1","externalId":42},
+		 "assets":[{"flavor":"iosSoftware","size":1000},{"flavor":"iosSoftwareMini","size":10}]},
+		{"type":"update","price":0,"priceFormatted":"Free","version":{"display":"2.1","externalId":42}}
+	],
+	"fileSizeByDevice":{"iPhone15,2":900,"iPad13,1":1200,"universal":1100},
+	"screenshotsByType":{"iphone6+":[{"url":"c"}],"ipadPro":[{"url":"a"},{"url":"b"}]},
+	"artwork":{"url":"https://example.com/icon/{w}x{h}{c}.{f}","width":1024,"height":1024},
+	"copyright":"© 2024 Test Inc.",
+	"contentRatingsBySystem":{"appsApple":{"name":"9+","value":200,"rank":2,
+		"advisories":["Infrequent/Mild Cartoon or Fantasy Violence"]}}
+}}}`
+
+// staticClient answers every request with the same body.
+type staticClient struct {
+	body string
+}
+
+func (c *staticClient) Do(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(c.body)),
+		Request:    req,
+	}, nil
+}
+
+func TestApplications_RichMetadata(t *testing.T) {
+	t.Parallel()
+
+	client, err := goitunes.New("us", goitunes.WithHTTPClient(&staticClient{body: richLookupBody}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	apps, err := client.Applications().GetByAdamID(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(apps) != 1 {
+		t.Fatalf("Expected 1 app, got %d", len(apps))
+	}
+
+	app := apps[0]
+
+	if len(app.Genres) != 2 || app.Genres[1].Name != "Casual" || app.GenreID != "6014" {
+		t.Errorf("Unexpected genres: %+v (primary %s)", app.Genres, app.GenreID)
+	}
+
+	if len(app.Offers) != 2 || app.Offers[0].BuyParams == "" || app.Offers[0].Currency != "USD" {
+		t.Errorf("Unexpected offers: %+v", app.Offers)
+	}
+
+	if app.Price != 1.99 || app.VersionID != 42 {
+		t.Errorf("Expected price and version from the first offer, got %v %d", app.Price, app.VersionID)
+	}
+
+	if len(app.FileSizeByDevice) != 3 || app.FileSizeByDevice["iPad13,1"] != 1200 {
+		t.Errorf("Unexpected per-device sizes: %v", app.FileSizeByDevice)
+	}
+
+	if app.FileSize != 1000 {
+		t.Errorf("Expected file size of the software asset, got %d", app.FileSize)
+	}
+
+	if strings.Join(app.ScreenshotURLs, ",") != "a,b,c" {
+		t.Errorf("Expected screenshots in stable order, got %v", app.ScreenshotURLs)
+	}
+
+	if app.Artwork.URLTemplate == "" || app.Artwork.Width != 1024 || app.Artwork.Height != 1024 {
+		t.Errorf("Unexpected artwork: %+v", app.Artwork)
+	}
+
+	if app.Copyright != "© 2024 Test Inc." {
+		t.Errorf("Unexpected copyright: %q", app.Copyright)
+	}
+
+	if app.ContentRating == nil || app.ContentRating.Label != "9+" || app.ContentRating.MinimumAge != 9 ||
+		len(app.ContentRating.Advisories) != 1 {
+		t.Errorf("Unexpected content rating: %+v", app.ContentRating)
+	}
+}
+
+func TestApplications_FileSizeWithoutAssets(t *testing.T) {
+	t.Parallel()
+
+	body := `{"results":{"1":{"id":"1","offers":[{"price":0}],
+		"fileSizeByDevice":{"a":300,"b":700,"c":500,"d":100,"e":600}}}}`
+
+	client, err := goitunes.New("us", goitunes.WithHTTPClient(&staticClient{body: body}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for range 10 {
+		apps, err := client.Applications().GetByAdamID(context.Background(), "1")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if apps[0].FileSize != 700 {
+			t.Fatalf("Expected the largest per-device size, got %d", apps[0].FileSize)
+		}
+	}
+}
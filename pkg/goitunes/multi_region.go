@@ -0,0 +1,274 @@
+package goitunes
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/infrastructure/config"
+	infrahttp "github.com/truewebber/goitunes/v2/internal/infrastructure/http"
+)
+
+// DefaultRegionConcurrency is the default number of regions queried concurrently.
+const DefaultRegionConcurrency = 8
+
+// MultiRegionClient runs the same request against several App Store regions concurrently.
+// All regional clients share one HTTP client and therefore one transport.
+type MultiRegionClient struct {
+	clients      map[string]*Client
+	charts       *MultiRegionChartService
+	applications *MultiRegionApplicationService
+	regions      []string
+	concurrency  int
+}
+
+// RegionResult holds the outcome of a call in a single region.
+type RegionResult[T any] struct {
+	Value T
+	Err   error
+}
+
+// RegionResults maps region codes to per-region outcomes.
+type RegionResults[T any] map[string]RegionResult[T]
+
+// NewMultiRegion creates a client for every given region.
+// Options are applied to each regional client; use WithRegionConcurrency
+// to bound the number of regions queried at once.
+func NewMultiRegion(regions []string, opts ...Option) (*MultiRegionClient, error) {
+	if len(regions) == 0 {
+		return nil, fmt.Errorf("%w: at least one region is required", ErrInvalidRequest)
+	}
+
+	// Prepend the shared HTTP client so a user-provided one still takes precedence
+	// and is shared by all regions as well.
+	sharedOpts := append([]Option{WithHTTPClient(infrahttp.NewDefaultClient())}, opts...)
+
+	multi := &MultiRegionClient{
+		clients:     make(map[string]*Client, len(regions)),
+		regions:     make([]string, 0, len(regions)),
+		concurrency: DefaultRegionConcurrency,
+	}
+
+	for _, region := range regions {
+		client, err := New(region, sharedOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("create client for region %s: %w", region, err)
+		}
+
+		if _, exists := multi.clients[client.Region()]; exists {
+			continue
+		}
+
+		multi.clients[client.Region()] = client
+		multi.regions = append(multi.regions, client.Region())
+
+		if client.regionConcurrency > 0 {
+			multi.concurrency = client.regionConcurrency
+		}
+	}
+
+	multi.charts = &MultiRegionChartService{multi: multi}
+	multi.applications = &MultiRegionApplicationService{multi: multi}
+
+	return multi, nil
+}
+
+// Charts returns the multi-region chart service.
+func (m *MultiRegionClient) Charts() *MultiRegionChartService {
+	return m.charts
+}
+
+// Applications returns the multi-region application service.
+func (m *MultiRegionClient) Applications() *MultiRegionApplicationService {
+	return m.applications
+}
+
+// Regions returns the configured regions in the order they were given.
+func (m *MultiRegionClient) Regions() []string {
+	regions := make([]string, len(m.regions))
+	copy(regions, m.regions)
+
+	return regions
+}
+
+// Client returns the client of a single region. The region code is case-insensitive.
+func (m *MultiRegionClient) Client(region string) (*Client, bool) {
+	client, ok := m.clients[config.NormalizeRegion(region)]
+
+	return client, ok
+}
+
+// Values returns the results of the regions that succeeded.
+func (r RegionResults[T]) Values() map[string]T {
+	values := make(map[string]T, len(r))
+
+	for region, result := range r {
+		if result.Err == nil {
+			values[region] = result.Value
+		}
+	}
+
+	return values
+}
+
+// Errors returns the errors of the regions that failed.
+func (r RegionResults[T]) Errors() map[string]error {
+	errs := make(map[string]error)
+
+	for region, result := range r {
+		if result.Err != nil {
+			errs[region] = result.Err
+		}
+	}
+
+	return errs
+}
+
+// fanOut calls fn for every region with at most m.concurrency calls in flight.
+// A failing region does not cancel the others; its error is kept in the results.
+func fanOut[T any](
+	ctx context.Context,
+	m *MultiRegionClient,
+	fn func(ctx context.Context, client *Client) (T, error),
+) RegionResults[T] {
+	var mu sync.Mutex
+
+	results := make(RegionResults[T], len(m.regions))
+
+	group := new(errgroup.Group)
+	group.SetLimit(m.concurrency)
+
+	for _, region := range m.regions {
+		client := m.clients[region]
+
+		group.Go(func() error {
+			var result RegionResult[T]
+
+			if err := ctx.Err(); err != nil {
+				result.Err = err
+			} else {
+				result.Value, result.Err = fn(ctx, client)
+			}
+
+			mu.Lock()
+			results[region] = result
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	//nolint:errcheck // goroutines never return an error, failures are kept per region
+	_ = group.Wait()
+
+	return results
+}
+
+// MultiRegionChartService provides chart methods across regions.
+type MultiRegionChartService struct {
+	multi *MultiRegionClient
+}
+
+// GetTop200 retrieves the top 200 applications in every region.
+func (s *MultiRegionChartService) GetTop200(
+	ctx context.Context,
+	genre Genre,
+	chartType ChartType,
+	options ...Top200Option,
+) RegionResults[*dto.GetTopChartsResponse] {
+	return fanOut(ctx, s.multi, func(ctx context.Context, client *Client) (*dto.GetTopChartsResponse, error) {
+		return client.Charts().GetTop200(ctx, genre, chartType, options...)
+	})
+}
+
+// GetTop1500 retrieves up to 1500 applications in every region.
+func (s *MultiRegionChartService) GetTop1500(
+	ctx context.Context,
+	genre Genre,
+	chartType ChartType,
+	page, pageSize int,
+) RegionResults[*dto.GetTopChartsResponse] {
+	return fanOut(ctx, s.multi, func(ctx context.Context, client *Client) (*dto.GetTopChartsResponse, error) {
+		return client.Charts().GetTop1500(ctx, genre, chartType, page, pageSize)
+	})
+}
+
+// Snapshot captures the top 200 chart in every region.
+func (s *MultiRegionChartService) Snapshot(
+	ctx context.Context,
+	genre Genre,
+	chartType ChartType,
+	options ...Top200Option,
+) RegionResults[*ChartSnapshot] {
+	return fanOut(ctx, s.multi, func(ctx context.Context, client *Client) (*ChartSnapshot, error) {
+		return client.Charts().Snapshot(ctx, genre, chartType, options...)
+	})
+}
+
+// MultiRegionApplicationService provides application methods across regions.
+type MultiRegionApplicationService struct {
+	multi *MultiRegionClient
+}
+
+// GetByAdamID retrieves application information by Adam IDs in every region.
+func (s *MultiRegionApplicationService) GetByAdamID(
+	ctx context.Context,
+	adamIDs ...string,
+) RegionResults[[]dto.ApplicationDTO] {
+	return fanOut(ctx, s.multi, func(ctx context.Context, client *Client) ([]dto.ApplicationDTO, error) {
+		return client.Applications().GetByAdamID(ctx, adamIDs...)
+	})
+}
+
+// GetByBundleID retrieves application information by Bundle IDs in every region.
+func (s *MultiRegionApplicationService) GetByBundleID(
+	ctx context.Context,
+	bundleIDs ...string,
+) RegionResults[[]dto.ApplicationDTO] {
+	return fanOut(ctx, s.multi, func(ctx context.Context, client *Client) ([]dto.ApplicationDTO, error) {
+		return client.Applications().GetByBundleID(ctx, bundleIDs...)
+	})
+}
+
+// LookupByAdamID looks up applications by Adam IDs in every region, with one result per ID.
+func (s *MultiRegionApplicationService) LookupByAdamID(
+	ctx context.Context,
+	adamIDs ...string,
+) RegionResults[LookupResults] {
+	return fanOut(ctx, s.multi, func(ctx context.Context, client *Client) (LookupResults, error) {
+		return client.Applications().LookupByAdamID(ctx, adamIDs...)
+	})
+}
+
+// LookupByBundleID looks up applications by Bundle IDs in every region, with one result per ID.
+func (s *MultiRegionApplicationService) LookupByBundleID(
+	ctx context.Context,
+	bundleIDs ...string,
+) RegionResults[LookupResults] {
+	return fanOut(ctx, s.multi, func(ctx context.Context, client *Client) (LookupResults, error) {
+		return client.Applications().LookupByBundleID(ctx, bundleIDs...)
+	})
+}
+
+// GetRating retrieves rating information for an application in every region.
+func (s *MultiRegionApplicationService) GetRating(
+	ctx context.Context,
+	adamID string,
+) RegionResults[*dto.GetRatingResponse] {
+	return fanOut(ctx, s.multi, func(ctx context.Context, client *Client) (*dto.GetRatingResponse, error) {
+		return client.Applications().GetRating(ctx, adamID)
+	})
+}
+
+// GetOverallRating retrieves overall rating information for an application in every region.
+func (s *MultiRegionApplicationService) GetOverallRating(
+	ctx context.Context,
+	adamID string,
+) RegionResults[*dto.GetRatingResponse] {
+	return fanOut(ctx, s.multi, func(ctx context.Context, client *Client) (*dto.GetRatingResponse, error) {
+		return client.Applications().GetOverallRating(ctx, adamID)
+	})
+}
@@ -0,0 +1,171 @@
+package goitunes_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+// regionLookupClient answers lookup requests per region and records concurrency.
+type regionLookupClient struct {
+	failRegion  string
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+	calls       int
+}
+
+func (c *regionLookupClient) Do(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	c.calls++
+	c.inFlight++
+
+	if c.inFlight > c.maxInFlight {
+		c.maxInFlight = c.inFlight
+	}
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		c.inFlight--
+		c.mu.Unlock()
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+
+	region := req.URL.Query().Get("cc")
+	if region == c.failRegion {
+		return &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Body:       io.NopCloser(strings.NewReader("")),
+			Request:    req,
+		}, nil
+	}
+
+	body := `{"results":{"1":{"id":"1","bundleId":"com.test.app","name":"Test ` + region + `"}}}`
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestMultiRegion_GetByBundleID(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &regionLookupClient{failRegion: "gb"}
+
+	multi, err := goitunes.NewMultiRegion(
+		[]string{"us", "gb", "de", "jp", "us"},
+		goitunes.WithHTTPClient(httpClient),
+		goitunes.WithRegionConcurrency(2),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got := multi.Regions(); len(got) != 4 {
+		t.Errorf("Expected duplicate regions to be removed, got %v", got)
+	}
+
+	results := multi.Applications().GetByBundleID(context.Background(), "com.test.app")
+
+	if len(results) != 4 {
+		t.Fatalf("Expected results for 4 regions, got %d", len(results))
+	}
+
+	for _, region := range []string{"us", "de", "jp"} {
+		result := results[region]
+		if result.Err != nil {
+			t.Errorf("%s: unexpected error: %v", region, result.Err)
+
+			continue
+		}
+
+		if len(result.Value) != 1 || result.Value[0].Name != "Test "+region {
+			t.Errorf("%s: unexpected applications: %+v", region, result.Value)
+		}
+	}
+
+	if results["gb"].Err == nil {
+		t.Error("gb: expected an error")
+	}
+
+	if len(results.Values()) != 3 || len(results.Errors()) != 1 {
+		t.Errorf("Expected 3 values and 1 error, got %d and %d", len(results.Values()), len(results.Errors()))
+	}
+
+	if httpClient.maxInFlight > 2 {
+		t.Errorf("Expected at most 2 concurrent requests, got %d", httpClient.maxInFlight)
+	}
+}
+
+func TestMultiRegion_CanceledContext(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &regionLookupClient{}
+
+	multi, err := goitunes.NewMultiRegion([]string{"us", "gb"}, goitunes.WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := multi.Applications().GetByAdamID(ctx, "1")
+
+	for region, result := range results {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("%s: expected context.Canceled, got %v", region, result.Err)
+		}
+	}
+
+	if httpClient.calls != 0 {
+		t.Errorf("Expected no HTTP calls, got %d", httpClient.calls)
+	}
+}
+
+func TestNewMultiRegion_Errors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := goitunes.NewMultiRegion(nil); !errors.Is(err, goitunes.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest, got %v", err)
+	}
+
+	if _, err := goitunes.NewMultiRegion([]string{"us", "zz"}); err == nil {
+		t.Error("Expected error for unsupported region")
+	}
+}
+
+func TestMultiRegion_Client(t *testing.T) {
+	t.Parallel()
+
+	multi, err := goitunes.NewMultiRegion([]string{"us", "DE"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, region := range []string{"us", "US", " us ", "de", "De"} {
+		if _, ok := multi.Client(region); !ok {
+			t.Errorf("Expected a client for %q", region)
+		}
+	}
+
+	client, ok := multi.Client("DE")
+	if !ok || client.Region() != "de" {
+		t.Errorf("Expected the de client, got %v", client)
+	}
+
+	if _, ok = multi.Client("fr"); ok {
+		t.Error("Expected no client for an unconfigured region")
+	}
+}
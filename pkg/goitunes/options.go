@@ -63,6 +63,24 @@ func WithKbsync(kbsync string) Option {
 	}
 }
 
+// WithPod sets the pod (host prefix) of an existing session, as returned by a
+// previous Login. Without it the pod is discovered on the first redirect.
+func WithPod(pod int) Option {
+	return func(c *Client) error {
+		if c.credentials == nil {
+			return ErrInvalidCredentials
+		}
+
+		if pod <= 0 {
+			return fmt.Errorf("%w: pod must be positive", ErrInvalidRequest)
+		}
+
+		c.credentials.SetPod(pod)
+
+		return nil
+	}
+}
+
 // WithDevice sets custom device information.
 func WithDevice(guid, machineName, userAgent string) Option {
 	return func(c *Client) error {
@@ -76,3 +94,104 @@ func WithDevice(guid, machineName, userAgent string) Option {
 		return nil
 	}
 }
+
+// WithLookupBatchSize sets how many identifiers one application lookup request asks for.
+// Longer lists, and chart entries missing from a chart response, are split into batches.
+// Defaults to 50.
+func WithLookupBatchSize(size int) Option {
+	return func(c *Client) error {
+		if size <= 0 {
+			return fmt.Errorf("%w: lookup batch size must be positive", ErrInvalidRequest)
+		}
+
+		c.lookupBatchSize = size
+
+		return nil
+	}
+}
+
+// WithLookupConcurrency sets how many batches of an application lookup, or of
+// chart entries missing from a chart response, are requested concurrently. Defaults to 4.
+func WithLookupConcurrency(workers int) Option {
+	return func(c *Client) error {
+		if workers <= 0 {
+			return fmt.Errorf("%w: lookup concurrency must be positive", ErrInvalidRequest)
+		}
+
+		c.lookupConcurrency = workers
+
+		return nil
+	}
+}
+
+// WithRegionConcurrency sets how many regions a MultiRegionClient queries concurrently.
+// Defaults to DefaultRegionConcurrency. It has no effect on a single-region Client.
+func WithRegionConcurrency(regions int) Option {
+	return func(c *Client) error {
+		if regions <= 0 {
+			return fmt.Errorf("%w: region concurrency must be positive", ErrInvalidRequest)
+		}
+
+		c.regionConcurrency = regions
+
+		return nil
+	}
+}
+
+// WithCustomStore registers a storefront that is not part of the built-in list,
+// or overrides the storefront ID and host prefix of a known region while keeping
+// its country metadata. It lets a client use storefronts Apple launches before
+// the library knows them.
+func WithCustomStore(region string, storeFront, hostPrefix int) Option {
+	return func(c *Client) error {
+		var metadata valueobject.StoreMetadata
+		if known, err := c.storeRegistry.GetStore(region); err == nil {
+			metadata = known.Metadata()
+		}
+
+		store, err := valueobject.NewStoreWithMetadata(region, storeFront, hostPrefix, metadata)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+		}
+
+		if err := c.storeRegistry.RegisterStore(store); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+		}
+
+		return nil
+	}
+}
+
+// WithLanguage sets the language of application names, descriptions and other
+// textual metadata, e.g. "ja", "de-DE" or "en_us". By default the language of
+// the storefront is used. Use ContextWithLanguage to override it for a single call.
+func WithLanguage(tag string) Option {
+	return func(c *Client) error {
+		lang, err := valueobject.NewLanguage(tag)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+		}
+
+		c.language = lang
+
+		return nil
+	}
+}
+
+// WithPlatform sets the platform applications are looked up and charted for,
+// e.g. PlatformMac for the Mac App Store. OS names such as "macOS" or "tvOS" are
+// accepted as well. Charts are available for iPhone, iPad and Mac only; for other
+// platforms chart requests fail with ErrChartsNotSupported. By default iPhone
+// applications are used.
+func WithPlatform(platform Platform) Option {
+	return func(c *Client) error {
+		p, err := valueobject.NewPlatform(string(platform))
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+		}
+
+		c.platform = p
+
+		return nil
+	}
+}
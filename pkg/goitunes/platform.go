@@ -0,0 +1,38 @@
+package goitunes
+
+import "github.com/truewebber/goitunes/v2/internal/domain/valueobject"
+
+// Platform represents the device platform applications are looked up and
+// charted for.
+type Platform string
+
+const (
+	// PlatformIPhone represents iPhone applications. It is the default platform.
+	PlatformIPhone Platform = valueobject.PlatformNameIPhone
+	// PlatformIPad represents iPad applications.
+	PlatformIPad Platform = valueobject.PlatformNameIPad
+	// PlatformMac represents Mac App Store applications.
+	PlatformMac Platform = valueobject.PlatformNameMac
+	// PlatformAppleTV represents tvOS applications. The App Store has no tvOS charts.
+	PlatformAppleTV Platform = valueobject.PlatformNameAppleTV
+	// PlatformWatch represents watchOS applications. The App Store has no watchOS charts.
+	PlatformWatch Platform = valueobject.PlatformNameWatch
+	// PlatformVision represents visionOS applications. The App Store has no visionOS charts.
+	PlatformVision Platform = valueobject.PlatformNameVision
+)
+
+// String returns the platform name.
+func (p Platform) String() string {
+	return string(p)
+}
+
+// canonical returns the platform name for aliases such as "macOS" or "tvOS".
+// Unknown names are returned unchanged.
+func (p Platform) canonical() Platform {
+	platform, err := valueobject.NewPlatform(string(p))
+	if err != nil {
+		return p
+	}
+
+	return Platform(platform.Name())
+}
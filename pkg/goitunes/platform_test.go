@@ -0,0 +1,234 @@
+package goitunes_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+// platformClient records lookup platforms, chart popIds and genreIds.
+type platformClient struct {
+	mu        sync.Mutex
+	calls     int
+	platforms []string
+	popIDs    []string
+	genreIDs  []string
+	chartBody string // Chart response, an empty chart when not set
+}
+
+func (c *platformClient) Do(req *http.Request) (*http.Response, error) {
+	query := req.URL.Query()
+
+	c.mu.Lock()
+	if platform := query.Get("platform"); platform != "" {
+		c.platforms = append(c.platforms, platform)
+	}
+
+	if popID := query.Get("popId"); popID != "" {
+		c.popIDs = append(c.popIDs, popID)
+		c.genreIDs = append(c.genreIDs, query.Get("genreId"))
+	}
+
+	c.calls++
+	c.mu.Unlock()
+
+	body := `{"results":{"1":{"id":"1","bundleId":"com.test.app","name":"Test",` +
+		`"deviceFamilies":["iphone","ipad","ipod","mac","realityDevice"]}}}`
+	if query.Has("popId") {
+		body = `{"pageData":{"segmentedControl":{"segments":[{"pageData":{"selectedChart":{"adamIds":[]}}}]}}}`
+		if c.chartBody != "" {
+			body = c.chartBody
+		}
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestWithPlatform_Lookup(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		opts             []goitunes.Option
+		expectedPlatform goitunes.Platform
+		expectedLookup   string
+	}{
+		{"Default", nil, goitunes.PlatformIPhone, "itunes"},
+		{"iPad", []goitunes.Option{goitunes.WithPlatform(goitunes.PlatformIPad)}, goitunes.PlatformIPad, "ipad"},
+		{"Mac", []goitunes.Option{goitunes.WithPlatform(goitunes.PlatformMac)}, goitunes.PlatformMac, "osx"},
+		{"tvOS name", []goitunes.Option{goitunes.WithPlatform("tvOS")}, goitunes.PlatformAppleTV, "appletv"},
+		{"visionOS name", []goitunes.Option{goitunes.WithPlatform("visionOS")}, goitunes.PlatformVision, "xros"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := &platformClient{}
+
+			client, err := goitunes.New("us", append(tt.opts, goitunes.WithHTTPClient(httpClient))...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if client.Platform() != tt.expectedPlatform {
+				t.Errorf("Expected platform %s, got %s", tt.expectedPlatform, client.Platform())
+			}
+
+			apps, err := client.Applications().GetByBundleID(context.Background(), "com.test.app")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(httpClient.platforms) != 1 || httpClient.platforms[0] != tt.expectedLookup {
+				t.Errorf("Expected platform=%s, got %v", tt.expectedLookup, httpClient.platforms)
+			}
+
+			if len(apps) != 1 {
+				t.Fatalf("Expected 1 app, got %d", len(apps))
+			}
+
+			app := apps[0]
+			if !app.SupportsMac() || !app.SupportsVision() || app.SupportsTV() || app.SupportsWatch() {
+				t.Errorf("Unexpected platforms %v", app.Platforms)
+			}
+
+			if strings.Join(app.Platforms, ",") != "ipad,iphone,mac,vision" {
+				t.Errorf("Expected platforms ipad,iphone,mac,vision, got %v", app.Platforms)
+			}
+		})
+	}
+}
+
+// macChartBody is a Mac App Store top free chart in the viewTop format, with
+// Mac apps of the Developer Tools and Productivity genres.
+const macChartBody = `{
+	"storePlatformData":{"lockup":{"results":{
+		"497799835":{"id":"497799835","bundleId":"com.apple.dt.Xcode","name":"Xcode",
+			"artistName":"Apple","artistId":"284417353","deviceFamilies":["mac"],
+			"genres":[{"genreId":"12002","name":"Developer Tools","mediaType":"12"}],
+			"offers":[{"type":"get","price":0,"priceFormatted":"Free"}]},
+		"409201541":{"id":"409201541","bundleId":"com.apple.iWork.Pages","name":"Pages",
+			"artistName":"Apple","artistId":"284417353","deviceFamilies":["mac"],
+			"genres":[{"genreId":"12014","name":"Productivity","mediaType":"12"}],
+			"offers":[{"type":"get","price":0,"priceFormatted":"Free"}]}
+	}}},
+	"pageData":{"segmentedControl":{"selectedIndex":0,"segments":[
+		{"pageData":{"selectedChart":{"adamIds":["497799835","409201541"]}}}
+	]}}
+}`
+
+func TestWithPlatform_Charts(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &platformClient{chartBody: macChartBody}
+
+	client, err := goitunes.New("us", goitunes.WithHTTPClient(httpClient), goitunes.WithPlatform(goitunes.PlatformMac))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	resp, err := client.Charts().GetTop200(context.Background(), goitunes.GenreAll, goitunes.ChartTypeTopFree)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(resp.Items) != 2 || resp.Items[0].App.BundleID != "com.apple.dt.Xcode" || resp.Items[1].App.Name != "Pages" {
+		t.Fatalf("Unexpected Mac chart items %+v", resp.Items)
+	}
+
+	if !resp.Items[0].App.SupportsMac() || resp.Items[0].App.SupportsPlatform("iphone") {
+		t.Errorf("Expected a Mac-only app, got platforms %v", resp.Items[0].App.Platforms)
+	}
+
+	_, err = client.Charts().GetTop200(context.Background(), goitunes.GenreMacDeveloperTools, goitunes.ChartTypeTopPaid)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if strings.Join(httpClient.popIDs, ",") != "34,35" {
+		t.Errorf("Expected Mac popIds 34,35, got %v", httpClient.popIDs)
+	}
+
+	if strings.Join(httpClient.genreIDs, ",") != "39,12002" {
+		t.Errorf("Expected Mac genreIds 39,12002, got %v", httpClient.genreIDs)
+	}
+}
+
+func TestWithPlatform_GenreNotSupported(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		platform goitunes.Platform
+		genre    goitunes.Genre
+		options  []goitunes.Top200Option
+	}{
+		{"iPhone genre on Mac", goitunes.PlatformMac, goitunes.GenreGames, nil},
+		{"Age band on Mac", goitunes.PlatformMac, goitunes.GenreAll,
+			[]goitunes.Top200Option{goitunes.WithAgeBand(goitunes.AgeBandSixToEight)}},
+		{"Mac genre on iPhone", goitunes.PlatformIPhone, goitunes.GenreMacGames, nil},
+		{"Mac root genre on iPad", goitunes.PlatformIPad, goitunes.GenreMacAll, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := &platformClient{}
+
+			client, err := goitunes.New("us", goitunes.WithHTTPClient(httpClient), goitunes.WithPlatform(tt.platform))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			_, err = client.Charts().GetTop200(context.Background(), tt.genre, goitunes.ChartTypeTopFree, tt.options...)
+			if !errors.Is(err, goitunes.ErrGenreNotSupported) {
+				t.Errorf("Expected ErrGenreNotSupported, got %v", err)
+			}
+
+			if httpClient.calls != 0 {
+				t.Errorf("Expected no requests, got %d", httpClient.calls)
+			}
+		})
+	}
+}
+
+func TestWithPlatform_ChartsNotSupported(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &platformClient{}
+
+	client, err := goitunes.New("us", goitunes.WithHTTPClient(httpClient), goitunes.WithPlatform(goitunes.PlatformAppleTV))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	_, err = client.Charts().GetTop200(context.Background(), "36", goitunes.ChartTypeTopFree)
+	if !errors.Is(err, goitunes.ErrChartsNotSupported) {
+		t.Errorf("Expected ErrChartsNotSupported, got %v", err)
+	}
+
+	if httpClient.calls != 0 {
+		t.Errorf("Expected no requests, got %d", httpClient.calls)
+	}
+}
+
+func TestWithPlatform_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := goitunes.New("us", goitunes.WithPlatform("android"))
+	if !errors.Is(err, goitunes.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest, got %v", err)
+	}
+}
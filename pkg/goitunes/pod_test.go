@@ -0,0 +1,184 @@
+package goitunes_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+const (
+	authOKBody = `<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0"><dict>
+	<key>passwordToken</key><string>token</string>
+	<key>dsPersonId</key><string>12345</string>
+</dict></plist>`
+
+	buyOKBody = `<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0"><dict>
+	<key>songList</key><array><dict>
+		<key>URL</key><string>https://example.com/app.ipa</string>
+		<key>download-id</key><string>download-1</string>
+		<key>sinfs</key><array><dict><key>id</key><integer>0</integer><key>sinf</key><data>AAEC</data></dict></array>
+		<key>metadata</key><dict><key>softwareVersionBundleId</key><string>com.test.app</string></dict>
+	</dict></array>
+</dict></plist>`
+)
+
+// podRedirectClient simulates Apple moving a session between pods.
+// Redirects maps "host path" to the pod the request is redirected to.
+type podRedirectClient struct {
+	redirects map[string]int
+	mu        sync.Mutex
+	requests  []string
+}
+
+func (c *podRedirectClient) Do(req *http.Request) (*http.Response, error) {
+	key := req.URL.Host + " " + req.URL.Path
+
+	c.mu.Lock()
+	c.requests = append(c.requests, key)
+	c.mu.Unlock()
+
+	if pod, ok := c.redirects[key]; ok {
+		location := "https://p" + strconv.Itoa(pod) + "-buy.itunes.apple.com" + req.URL.Path + "?Pod=" + strconv.Itoa(pod)
+
+		return &http.Response{
+			StatusCode: http.StatusFound,
+			Header:     http.Header{"Location": {location}},
+			Body:       io.NopCloser(strings.NewReader("")),
+			Request:    req,
+		}, nil
+	}
+
+	body := ""
+
+	switch {
+	case strings.HasSuffix(req.URL.Path, "/authenticate"):
+		body = authOKBody
+	case strings.HasSuffix(req.URL.Path, "/buyProduct"):
+		body = buyOKBody
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestPodDiscovery_RedirectChain(t *testing.T) {
+	t.Parallel()
+
+	const (
+		authPath     = "/WebObjects/MZFinance.woa/wa/authenticate"
+		buyPath      = "/WebObjects/MZBuy.woa/wa/buyProduct"
+		downloadPath = "/WebObjects/MZFastFinance.woa/wa/songDownloadDone"
+	)
+
+	httpClient := &podRedirectClient{
+		redirects: map[string]int{
+			// Login: the store pod redirects to 71, which redirects to 25
+			"p36-buy.itunes.apple.com " + authPath: 71,
+			"p71-buy.itunes.apple.com " + authPath: 25,
+			// Purchase: the session later moves from 25 to 30
+			"p25-buy.itunes.apple.com " + buyPath: 30,
+		},
+	}
+
+	client, err := goitunes.New("us",
+		goitunes.WithHTTPClient(httpClient),
+		goitunes.WithAppleID("user@example.com"),
+		goitunes.WithKbsync("kbsync"),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	resp, err := client.Auth().Login(context.Background(), "password")
+	if err != nil {
+		t.Fatalf("Unexpected login error: %v", err)
+	}
+
+	if resp.Pod != 25 || client.Pod() != 25 {
+		t.Errorf("Expected discovered pod 25, got %d (client %d)", resp.Pod, client.Pod())
+	}
+
+	if _, err := client.Purchase().Buy(context.Background(), "1", 1); err != nil {
+		t.Fatalf("Unexpected purchase error: %v", err)
+	}
+
+	if client.Pod() != 30 {
+		t.Errorf("Expected re-discovered pod 30, got %d", client.Pod())
+	}
+
+	expected := []string{
+		"p36-buy.itunes.apple.com " + authPath,
+		"p71-buy.itunes.apple.com " + authPath,
+		"p25-buy.itunes.apple.com " + authPath,
+		"p25-buy.itunes.apple.com " + buyPath,
+		"p30-buy.itunes.apple.com " + buyPath,
+		"p30-buy.itunes.apple.com " + downloadPath,
+	}
+
+	if strings.Join(httpClient.requests, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Unexpected request chain:\n%s", strings.Join(httpClient.requests, "\n"))
+	}
+}
+
+func TestPodDiscovery_WithPod(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &podRedirectClient{}
+
+	client, err := goitunes.New("us",
+		goitunes.WithHTTPClient(httpClient),
+		goitunes.WithCredentials("user@example.com", "token", "12345"),
+		goitunes.WithKbsync("kbsync"),
+		goitunes.WithPod(42),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err := client.Purchase().Buy(context.Background(), "1", 1); err != nil {
+		t.Fatalf("Unexpected purchase error: %v", err)
+	}
+
+	for _, request := range httpClient.requests {
+		if !strings.HasPrefix(request, "p42-buy.") {
+			t.Errorf("Expected request to pod 42, got %s", request)
+		}
+	}
+}
+
+func TestPodDiscovery_TooManyRedirects(t *testing.T) {
+	t.Parallel()
+
+	const buyPath = "/WebObjects/MZBuy.woa/wa/buyProduct"
+
+	httpClient := &podRedirectClient{
+		redirects: map[string]int{
+			"p36-buy.itunes.apple.com " + buyPath: 37,
+			"p37-buy.itunes.apple.com " + buyPath: 36,
+		},
+	}
+
+	client, err := goitunes.New("us",
+		goitunes.WithHTTPClient(httpClient),
+		goitunes.WithCredentials("user@example.com", "token", "12345"),
+		goitunes.WithKbsync("kbsync"),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err := client.Purchase().Buy(context.Background(), "1", 1); err == nil {
+		t.Error("Expected an error for a redirect loop")
+	}
+}
@@ -0,0 +1,64 @@
+package goitunes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/application/usecase"
+	"github.com/truewebber/goitunes/v2/internal/domain/service"
+)
+
+// ExchangeRateProvider supplies conversion rates between ISO 4217 currencies.
+// Implement it to plug in a live rates source.
+type ExchangeRateProvider = service.ExchangeRateProvider
+
+// StaticExchangeRates is an offline ExchangeRateProvider backed by a fixed rates table.
+type StaticExchangeRates = service.StaticExchangeRates
+
+// PriceComparison holds application prices across regions converted to one currency.
+type PriceComparison = dto.PriceComparisonDTO
+
+// AppPriceComparison holds the regional prices of a single application.
+type AppPriceComparison = dto.AppPriceComparisonDTO
+
+// RegionPrice holds an application price in a single region.
+type RegionPrice = dto.RegionPriceDTO
+
+// NewStaticExchangeRates creates a provider from units of each currency per one unit of base,
+// e.g. NewStaticExchangeRates("USD", map[string]float64{"EUR": 0.85}).
+func NewStaticExchangeRates(base string, rates map[string]float64) (*StaticExchangeRates, error) {
+	provider, err := service.NewStaticExchangeRates(base, rates)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+
+	return provider, nil
+}
+
+// DefaultExchangeRates returns a provider with built-in approximate rates against USD.
+// The rates are not kept up to date; use a live provider when accuracy matters.
+func DefaultExchangeRates() *StaticExchangeRates {
+	return service.DefaultExchangeRates()
+}
+
+// ComparePrices converts the prices of applications looked up in several regions
+// to baseCurrency. It accepts the successful values of a multi-region lookup:
+//
+//	results := multi.Applications().GetByBundleID(ctx, "com.example.app")
+//	comparison, err := goitunes.ComparePrices(ctx, results.Values(), "USD", goitunes.DefaultExchangeRates())
+//
+// Prices that cannot be converted are reported per region instead of failing the comparison.
+func ComparePrices(
+	ctx context.Context,
+	appsByRegion map[string][]dto.ApplicationDTO,
+	baseCurrency string,
+	rates ExchangeRateProvider,
+) (*PriceComparison, error) {
+	comparison, err := usecase.NewComparePrices(rates).Execute(ctx, baseCurrency, appsByRegion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare prices: %w", err)
+	}
+
+	return comparison, nil
+}
@@ -0,0 +1,54 @@
+package goitunes
+
+import (
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/domain/entity"
+)
+
+// PrivacyDetails is the App Privacy ("nutrition label") of an application.
+type PrivacyDetails = dto.PrivacyDetailsDTO
+
+// PrivacySection is one section of the App Privacy label, e.g. data linked to you.
+type PrivacySection = dto.PrivacySectionDTO
+
+// PrivacyPurpose lists the data categories collected for a purpose.
+type PrivacyPurpose = dto.PrivacyPurposeDTO
+
+// App Privacy sections, the values of a section Type.
+const (
+	PrivacyTypeUsedToTrackYou = string(entity.PrivacyTypeUsedToTrackYou)
+	PrivacyTypeLinkedToYou    = string(entity.PrivacyTypeLinkedToYou)
+	PrivacyTypeNotLinkedToYou = string(entity.PrivacyTypeNotLinkedToYou)
+	PrivacyTypeNotCollected   = string(entity.PrivacyTypeNotCollected)
+	PrivacyTypeUnknown        = string(entity.PrivacyTypeUnknown)
+)
+
+// App Privacy data categories, the values of Categories.
+const (
+	PrivacyCategoryContactInfo      = string(entity.PrivacyCategoryContactInfo)
+	PrivacyCategoryHealthAndFitness = string(entity.PrivacyCategoryHealthAndFitness)
+	PrivacyCategoryFinancialInfo    = string(entity.PrivacyCategoryFinancialInfo)
+	PrivacyCategoryLocation         = string(entity.PrivacyCategoryLocation)
+	PrivacyCategorySensitiveInfo    = string(entity.PrivacyCategorySensitiveInfo)
+	PrivacyCategoryContacts         = string(entity.PrivacyCategoryContacts)
+	PrivacyCategoryUserContent      = string(entity.PrivacyCategoryUserContent)
+	PrivacyCategoryBrowsingHistory  = string(entity.PrivacyCategoryBrowsingHistory)
+	PrivacyCategorySearchHistory    = string(entity.PrivacyCategorySearchHistory)
+	PrivacyCategoryIdentifiers      = string(entity.PrivacyCategoryIdentifiers)
+	PrivacyCategoryPurchases        = string(entity.PrivacyCategoryPurchases)
+	PrivacyCategoryUsageData        = string(entity.PrivacyCategoryUsageData)
+	PrivacyCategoryDiagnostics      = string(entity.PrivacyCategoryDiagnostics)
+	PrivacyCategoryOther            = string(entity.PrivacyCategoryOther)
+	PrivacyCategoryUnknown          = string(entity.PrivacyCategoryUnknown)
+)
+
+// App Privacy purposes, the values of a purpose Purpose.
+const (
+	PrivacyPurposeThirdPartyAdvertising  = string(entity.PrivacyPurposeThirdPartyAdvertising)
+	PrivacyPurposeDevelopersAdvertising  = string(entity.PrivacyPurposeDevelopersAdvertising)
+	PrivacyPurposeAnalytics              = string(entity.PrivacyPurposeAnalytics)
+	PrivacyPurposeProductPersonalization = string(entity.PrivacyPurposeProductPersonalization)
+	PrivacyPurposeAppFunctionality       = string(entity.PrivacyPurposeAppFunctionality)
+	PrivacyPurposeOther                  = string(entity.PrivacyPurposeOther)
+	PrivacyPurposeUnknown                = string(entity.PrivacyPurposeUnknown)
+)
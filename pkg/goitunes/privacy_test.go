@@ -0,0 +1,86 @@
+package goitunes_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+const privacyBody = `{"storePlatformData":{"product-dv":{"results":{"1":{
+	"id":"1","bundleId":"com.test.app","name":"Test",
+	"privacy":{
+		"privacyPolicyUrl":"https://example.com/privacy",
+		"privacyTypes":[
+			{"identifier":"DATA_USED_TO_TRACK_YOU","dataCategories":[{"identifier":"IDENTIFIERS"},{"identifier":"USAGE_DATA"}]},
+			{"identifier":"DATA_LINKED_TO_YOU","purposes":[
+				{"identifier":"ANALYTICS","dataCategories":[{"identifier":"CONTACT_INFO"}]},
+				{"identifier":"APP_FUNCTIONALITY","dataCategories":[{"identifier":"LOCATION"},{"identifier":"NEURAL_ACTIVITY"}]}
+			]},
+			{"identifier":"DATA_SHARED_WITH_ALIENS"}
+		]
+	}
+}}}}}`
+
+// privacySection returns the section of details with the given type.
+func privacySection(details *goitunes.PrivacyDetails, privacyType string) (*goitunes.PrivacySection, bool) {
+	for i := range details.Sections {
+		if details.Sections[i].Type == privacyType {
+			return &details.Sections[i], true
+		}
+	}
+
+	return nil, false
+}
+
+func TestApplications_GetPrivacyDetails(t *testing.T) {
+	t.Parallel()
+
+	client, err := goitunes.New("us", goitunes.WithHTTPClient(&staticClient{body: privacyBody}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	details, err := client.Applications().GetPrivacyDetails(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if details.AdamID != "1" || details.PolicyURL != "https://example.com/privacy" {
+		t.Errorf("Unexpected details: %+v", details)
+	}
+
+	tracking, ok := privacySection(details, goitunes.PrivacyTypeUsedToTrackYou)
+	if !ok {
+		t.Fatal("Expected a tracking section")
+	}
+
+	expectedCategories := []string{goitunes.PrivacyCategoryIdentifiers, goitunes.PrivacyCategoryUsageData}
+	if !slices.Equal(tracking.Categories, expectedCategories) {
+		t.Errorf("Expected %v, got %v", expectedCategories, tracking.Categories)
+	}
+
+	linked, ok := privacySection(details, goitunes.PrivacyTypeLinkedToYou)
+	if !ok || len(linked.Purposes) != 2 {
+		t.Fatalf("Expected a linked section with 2 purposes, got %+v", linked)
+	}
+
+	functionality := linked.Purposes[1]
+	if functionality.Purpose != goitunes.PrivacyPurposeAppFunctionality {
+		t.Errorf("Expected app functionality, got %s", functionality.Purpose)
+	}
+
+	if !slices.Equal(functionality.Categories,
+		[]string{goitunes.PrivacyCategoryLocation, goitunes.PrivacyCategoryUnknown}) {
+		t.Errorf("Expected location and an unknown category, got %v", functionality.Categories)
+	}
+
+	if _, ok = privacySection(details, goitunes.PrivacyTypeNotLinkedToYou); ok {
+		t.Error("Expected no not-linked section")
+	}
+
+	if _, ok = privacySection(details, goitunes.PrivacyTypeUnknown); !ok {
+		t.Error("Expected an unknown section for a new identifier")
+	}
+}
@@ -0,0 +1,12 @@
+package goitunes
+
+import "github.com/truewebber/goitunes/v2/internal/application/dto"
+
+// RelatedSection labels the section of an application page related applications are listed in.
+type RelatedSection = dto.RelatedSection
+
+// Related application sections.
+const (
+	RelatedSectionMoreByDeveloper  = dto.RelatedSectionMoreByDeveloper
+	RelatedSectionYouMightAlsoLike = dto.RelatedSectionYouMightAlsoLike
+)
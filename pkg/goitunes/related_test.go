@@ -0,0 +1,61 @@
+package goitunes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+const relatedBody = `{
+	"storePlatformData":{
+		"product-dv":{"results":{
+			"1":{"id":"1","bundleId":"com.test.one","name":"One","artistId":"42"},
+			"2":{"id":"2","bundleId":"com.test.two","name":"Two","artistId":"42"}
+		}},
+		"lockup":{"results":{
+			"3":{"id":"3","bundleId":"com.other.three","name":"Three","artistId":"7"},
+			"4":{"id":"4","bundleId":"com.other.four","name":"Four","artistId":"8"}
+		}}
+	},
+	"pageData":{
+		"moreByThisDeveloper":["1","2"],
+		"customersAlsoBoughtApps":["4","5","3"]
+	}
+}`
+
+func TestApplications_GetRelated(t *testing.T) {
+	t.Parallel()
+
+	client, err := goitunes.New("us", goitunes.WithHTTPClient(&staticClient{body: relatedBody}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	resp, err := client.Applications().GetRelated(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(resp.Sections) != 2 {
+		t.Fatalf("Expected 2 sections, got %d", len(resp.Sections))
+	}
+
+	moreByDeveloper := resp.Sections[0]
+	if moreByDeveloper.Section != goitunes.RelatedSectionMoreByDeveloper {
+		t.Errorf("Expected %s, got %s", goitunes.RelatedSectionMoreByDeveloper, moreByDeveloper.Section)
+	}
+
+	if len(moreByDeveloper.Applications) != 1 || moreByDeveloper.Applications[0].AdamID != "2" {
+		t.Errorf("Expected only app 2 besides the app itself, got %+v", moreByDeveloper.Applications)
+	}
+
+	alsoLike := resp.Sections[1]
+	if alsoLike.Section != goitunes.RelatedSectionYouMightAlsoLike {
+		t.Errorf("Expected %s, got %s", goitunes.RelatedSectionYouMightAlsoLike, alsoLike.Section)
+	}
+
+	if len(alsoLike.Applications) != 2 || alsoLike.Applications[0].AdamID != "4" || alsoLike.Applications[1].AdamID != "3" {
+		t.Errorf("Expected apps 4 and 3 in page order, got %+v", alsoLike.Applications)
+	}
+}
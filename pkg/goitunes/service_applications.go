@@ -10,11 +10,17 @@ import (
 
 // ApplicationService provides methods for retrieving application information.
 type ApplicationService struct {
-	getInfoUseCase   *usecase.GetApplicationInfo
-	getRatingUseCase *usecase.GetRating
+	getInfoUseCase           *usecase.GetApplicationInfo
+	getRatingUseCase         *usecase.GetRating
+	getInAppPurchasesUseCase *usecase.GetInAppPurchases
+	getVersionHistoryUseCase *usecase.GetVersionHistory
+	getByDeveloperUseCase    *usecase.GetDeveloperApplications
+	getRelatedUseCase        *usecase.GetRelated
+	getPrivacyUseCase        *usecase.GetPrivacyDetails
 }
 
-// GetByAdamID retrieves application information by Adam IDs.
+// GetByAdamID retrieves application information by Adam IDs, in request order.
+// IDs that are not found are skipped; use LookupByAdamID to get a status per ID.
 func (s *ApplicationService) GetByAdamID(ctx context.Context, adamIDs ...string) ([]dto.ApplicationDTO, error) {
 	if len(adamIDs) == 0 {
 		return nil, ErrInvalidRequest
@@ -32,7 +38,8 @@ func (s *ApplicationService) GetByAdamID(ctx context.Context, adamIDs ...string)
 	return resp.Applications, nil
 }
 
-// GetByBundleID retrieves application information by Bundle IDs.
+// GetByBundleID retrieves application information by Bundle IDs, in request order.
+// IDs that are not found are skipped; use LookupByBundleID to get a status per ID.
 func (s *ApplicationService) GetByBundleID(ctx context.Context, bundleIDs ...string) ([]dto.ApplicationDTO, error) {
 	if len(bundleIDs) == 0 {
 		return nil, ErrInvalidRequest
@@ -50,6 +57,37 @@ func (s *ApplicationService) GetByBundleID(ctx context.Context, bundleIDs ...str
 	return resp.Applications, nil
 }
 
+// LookupByAdamID looks up applications by Adam IDs and returns one result per
+// requested ID, in request order, telling whether it was found, not found, not
+// available in the storefront, or could not be fetched. Any number of IDs may be
+// passed; they are requested in batches.
+func (s *ApplicationService) LookupByAdamID(ctx context.Context, adamIDs ...string) (LookupResults, error) {
+	if len(adamIDs) == 0 {
+		return nil, ErrInvalidRequest
+	}
+
+	resp, err := s.getInfoUseCase.Lookup(ctx, dto.GetApplicationInfoRequest{AdamIDs: adamIDs})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up applications by adamID: %w", err)
+	}
+
+	return resp.Results, nil
+}
+
+// LookupByBundleID looks up applications by Bundle IDs like LookupByAdamID.
+func (s *ApplicationService) LookupByBundleID(ctx context.Context, bundleIDs ...string) (LookupResults, error) {
+	if len(bundleIDs) == 0 {
+		return nil, ErrInvalidRequest
+	}
+
+	resp, err := s.getInfoUseCase.Lookup(ctx, dto.GetApplicationInfoRequest{BundleIDs: bundleIDs})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up applications by bundleID: %w", err)
+	}
+
+	return resp.Results, nil
+}
+
 // GetRating retrieves rating information for an application.
 func (s *ApplicationService) GetRating(ctx context.Context, adamID string) (*dto.GetRatingResponse, error) {
 	if adamID == "" {
@@ -87,3 +125,109 @@ func (s *ApplicationService) GetOverallRating(ctx context.Context, adamID string
 
 	return resp, nil
 }
+
+// GetInAppPurchases retrieves the in-app purchases the App Store lists for an
+// application in the client's storefront, with prices in the storefront currency.
+func (s *ApplicationService) GetInAppPurchases(
+	ctx context.Context,
+	adamID string,
+) (*dto.GetInAppPurchasesResponse, error) {
+	if adamID == "" {
+		return nil, ErrInvalidRequest
+	}
+
+	resp, err := s.getInAppPurchasesUseCase.Execute(ctx, adamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get in-app purchases: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetVersionHistory retrieves the released versions of an application, oldest first,
+// with their release dates and "What's New" texts.
+func (s *ApplicationService) GetVersionHistory(
+	ctx context.Context,
+	adamID string,
+) (*dto.GetVersionHistoryResponse, error) {
+	if adamID == "" {
+		return nil, ErrInvalidRequest
+	}
+
+	resp, err := s.getVersionHistoryUseCase.Execute(ctx, adamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get version history: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetRelated retrieves the applications listed on an application page, grouped into
+// labelled sections: RelatedSectionMoreByDeveloper and RelatedSectionYouMightAlsoLike.
+func (s *ApplicationService) GetRelated(ctx context.Context, adamID string) (*dto.GetRelatedResponse, error) {
+	if adamID == "" {
+		return nil, ErrInvalidRequest
+	}
+
+	resp, err := s.getRelatedUseCase.Execute(ctx, adamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get related applications: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetPrivacyDetails retrieves the App Privacy label of an application: the data used
+// to track the user, the data linked and not linked to the user, with typed
+// categories and purposes.
+func (s *ApplicationService) GetPrivacyDetails(ctx context.Context, adamID string) (*PrivacyDetails, error) {
+	if adamID == "" {
+		return nil, ErrInvalidRequest
+	}
+
+	resp, err := s.getPrivacyUseCase.Execute(ctx, adamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get privacy details: %w", err)
+	}
+
+	return resp, nil
+}
+
+// DeveloperOption is a functional option for GetByDeveloper requests.
+type DeveloperOption func(*dto.GetByDeveloperRequest)
+
+// WithDeveloperLimit sets the maximum number of applications to retrieve, at most 200.
+// Apple's artist lookup cannot be paged, so only the first 200 applications of a larger
+// catalog are retrieved.
+func WithDeveloperLimit(limit int) DeveloperOption {
+	return func(req *dto.GetByDeveloperRequest) {
+		req.Limit = limit
+	}
+}
+
+// GetByDeveloper retrieves a developer profile and the developer's applications
+// available in the client's storefront, in the order the App Store lists them.
+// Developer.AppCount is the number of applications the developer lists, up to the limit;
+// Applications leaves out those the storefront no longer sells.
+func (s *ApplicationService) GetByDeveloper(
+	ctx context.Context,
+	artistID string,
+	opts ...DeveloperOption,
+) (*dto.GetByDeveloperResponse, error) {
+	if artistID == "" {
+		return nil, ErrInvalidRequest
+	}
+
+	req := dto.GetByDeveloperRequest{ArtistID: artistID}
+
+	for _, opt := range opts {
+		opt(&req)
+	}
+
+	resp, err := s.getByDeveloperUseCase.Execute(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get applications by developer: %w", err)
+	}
+
+	return resp, nil
+}
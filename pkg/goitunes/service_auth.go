@@ -18,6 +18,8 @@ type AuthService struct {
 
 // Login performs authentication with Apple ID and password.
 // After successful login, the client will be authenticated and can use purchase methods.
+// The pod Apple redirects the login to is kept and used for all later authenticated requests;
+// persist resp.Pod and pass it to WithPod to reuse it in a new client.
 func (s *AuthService) Login(ctx context.Context, password string) (*dto.AuthenticateResponse, error) {
 	if s.client.credentials == nil {
 		return nil, ErrInvalidCredentials
@@ -48,6 +50,8 @@ func (s *AuthService) Login(ctx context.Context, password string) (*dto.Authenti
 		credentials.SetKbsync(s.client.credentials.Kbsync())
 	}
 
+	credentials.SetPod(resp.Pod)
+
 	s.client.credentials = credentials
 
 	// Reinitialize auth and purchase repositories with new credentials
@@ -55,7 +59,7 @@ func (s *AuthService) Login(ctx context.Context, password string) (*dto.Authenti
 		s.client.httpClient,
 		s.client.store,
 		s.client.device,
-	)
+	).SetPod(resp.Pod)
 	s.client.purchaseRepo = appstore.NewPurchaseClient(
 		s.client.httpClient,
 		s.client.store,
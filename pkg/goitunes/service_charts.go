@@ -22,7 +22,9 @@ const (
 
 // ChartService provides methods for retrieving app charts.
 type ChartService struct {
-	useCase *usecase.GetTopCharts
+	useCase         *usecase.GetTopCharts
+	snapshotUseCase *usecase.TakeChartSnapshot
+	platform        Platform
 }
 
 const (
@@ -30,12 +32,14 @@ const (
 )
 
 // GetTop200 retrieves the top 200 applications for a genre and chart type.
+// Positions whose application info could not be resolved are listed in
+// the response's Unresolved field instead of being silently dropped.
 func (s *ChartService) GetTop200(
 	ctx context.Context,
 	genre Genre,
 	chartType ChartType,
 	options ...Top200Option,
-) ([]dto.ChartItemDTO, error) {
+) (*dto.GetTopChartsResponse, error) {
 	req := dto.GetTopChartsRequest{
 		GenreID:   genre.String(),
 		ChartType: string(chartType),
@@ -53,16 +57,18 @@ func (s *ChartService) GetTop200(
 		return nil, fmt.Errorf("failed to get top 200 charts: %w", err)
 	}
 
-	return resp.Items, nil
+	return resp, nil
 }
 
 // GetTop1500 retrieves up to 1500 applications for a genre and chart type.
+// The top 1500 service does not report the length of the chart, so TotalCount
+// of the response is the number of entries on the page.
 func (s *ChartService) GetTop1500(
 	ctx context.Context,
 	genre Genre,
 	chartType ChartType,
 	page, pageSize int,
-) ([]dto.ChartItemDTO, error) {
+) (*dto.GetTopChartsResponse, error) {
 	req := dto.GetTopChartsRequest{
 		GenreID:    genre.String(),
 		ChartType:  string(chartType),
@@ -75,7 +81,34 @@ func (s *ChartService) GetTop1500(
 		return nil, fmt.Errorf("failed to get top 1500 charts: %w", err)
 	}
 
-	return resp.Items, nil
+	return resp, nil
+}
+
+// Snapshot captures the top 200 chart for a genre and chart type at the current time.
+// Snapshots can be stored as JSON and compared later with Diff.
+func (s *ChartService) Snapshot(
+	ctx context.Context,
+	genre Genre,
+	chartType ChartType,
+	options ...Top200Option,
+) (*ChartSnapshot, error) {
+	req := dto.GetTopChartsRequest{
+		GenreID:   genre.String(),
+		ChartType: string(chartType),
+		From:      1,
+		Limit:     defaultTop200Limit,
+	}
+
+	for _, opt := range options {
+		opt(&req)
+	}
+
+	snapshot, err := s.snapshotUseCase.Execute(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take chart snapshot: %w", err)
+	}
+
+	return snapshot, nil
 }
 
 // Top200Option is a functional option for Top200 requests.
@@ -88,6 +121,11 @@ func WithKidPrefix(kidPrefix string) Top200Option {
 	}
 }
 
+// WithAgeBand limits a Kids chart to an age band, e.g. AgeBandSixToEight.
+func WithAgeBand(band AgeBand) Top200Option {
+	return WithKidPrefix(band.String())
+}
+
 // WithRange sets the range of results to retrieve.
 func WithRange(from, limit int) Top200Option {
 	return func(req *dto.GetTopChartsRequest) {
@@ -95,3 +133,13 @@ func WithRange(from, limit int) Top200Option {
 		req.Limit = limit
 	}
 }
+
+// WithMaxAgeRating keeps only applications whose content rating allows the given age.
+// Applications the store reports without a rating, or with a rating that has no
+// age such as "Not yet rated", are dropped as well;
+// the number of dropped applications is reported in the response's Filtered field.
+func WithMaxAgeRating(age int) Top200Option {
+	return func(req *dto.GetTopChartsRequest) {
+		req.MaxAge = age
+	}
+}
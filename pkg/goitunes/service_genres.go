@@ -0,0 +1,59 @@
+package goitunes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/truewebber/goitunes/v2/internal/application/usecase"
+)
+
+// GenreService provides methods for retrieving the App Store genre tree.
+type GenreService struct {
+	useCase *usecase.GetGenreCatalog
+	client  *Client
+}
+
+// Catalog retrieves the current genre tree of the storefront from Apple's genre
+// service, with names in the client language (see WithLanguage and ContextWithLanguage).
+func (s *GenreService) Catalog(ctx context.Context) (*GenreCatalog, error) {
+	catalog, err := s.useCase.Execute(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get genre catalog: %w", err)
+	}
+
+	catalog.Language = s.client.chartRepo.Language(ctx).Tag()
+	catalog.Platform = s.client.chartRepo.Platform().Name()
+
+	return catalog, nil
+}
+
+// Refresh retrieves the current genre tree and caches it in the file at path,
+// so genres Apple adds later are known without a library release.
+func (s *GenreService) Refresh(ctx context.Context, path string) (*GenreCatalog, error) {
+	catalog, err := s.Catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = SaveGenreCatalog(path, catalog); err != nil {
+		return nil, err
+	}
+
+	return catalog, nil
+}
+
+// Cached returns the genre catalog cached in the file at path if it was refreshed
+// within maxAge for the same storefront, language and platform. Otherwise it refreshes the cache.
+func (s *GenreService) Cached(ctx context.Context, path string, maxAge time.Duration) (*GenreCatalog, error) {
+	catalog, err := LoadGenreCatalog(path)
+	if err == nil &&
+		catalog.Region == s.client.Region() &&
+		catalog.Language == s.client.chartRepo.Language(ctx).Tag() &&
+		catalog.Platform == s.client.chartRepo.Platform().Name() &&
+		time.Since(catalog.RefreshedAt) < maxAge {
+		return catalog, nil
+	}
+
+	return s.Refresh(ctx, path)
+}
@@ -0,0 +1,31 @@
+package goitunes
+
+import (
+	"fmt"
+
+	"github.com/truewebber/goitunes/v2/internal/application/dto"
+	"github.com/truewebber/goitunes/v2/internal/application/usecase"
+)
+
+// ChartSnapshot is a chart captured at a point in time.
+// It is JSON-serialisable, so snapshots can be stored and diffed later.
+type ChartSnapshot = dto.ChartSnapshotDTO
+
+// ChartDiff describes what changed between two snapshots of the same chart.
+type ChartDiff = dto.ChartDiffDTO
+
+// ChartMovement describes a rank change of a single application.
+type ChartMovement = dto.ChartMovementDTO
+
+// Diff compares two snapshots of the same chart (storefront, genre, chart type,
+// device and age filters) and reports new entries, drop-outs and rank movements
+// from previous to current. Positions whose application could not be resolved
+// count as present, so a failed lookup is not reported as a drop-out.
+func Diff(previous, current *ChartSnapshot) (*ChartDiff, error) {
+	diff, err := usecase.NewDiffChartSnapshots().Execute(previous, current)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff chart snapshots: %w", err)
+	}
+
+	return diff, nil
+}
@@ -0,0 +1,272 @@
+// Package sqlitestore provides a storage.ChartStore on a SQLite database, using
+// the pure-Go driver modernc.org/sqlite. It is a package of its own so clients
+// that do not track chart history do not link the driver.
+package sqlitestore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	// Registers the pure-Go SQLite driver.
+	_ "modernc.org/sqlite"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes/storage"
+)
+
+// DriverName is the database/sql driver name of the pure-Go SQLite driver
+// modernc.org/sqlite, which this package registers.
+const DriverName = "sqlite"
+
+// sqliteSchema creates the tables of the chart store.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS chart_snapshots (
+	id          INTEGER PRIMARY KEY AUTOINCREMENT,
+	storefront  TEXT    NOT NULL,
+	genre_id    TEXT    NOT NULL,
+	chart_type  TEXT    NOT NULL,
+	device      TEXT    NOT NULL,
+	age_band    TEXT    NOT NULL,
+	max_age     INTEGER NOT NULL,
+	captured_at INTEGER NOT NULL,
+	UNIQUE (storefront, genre_id, chart_type, device, age_band, max_age, captured_at)
+);
+
+CREATE TABLE IF NOT EXISTS chart_positions (
+	snapshot_id INTEGER NOT NULL REFERENCES chart_snapshots (id) ON DELETE CASCADE,
+	position    INTEGER NOT NULL,
+	adam_id     TEXT    NOT NULL,
+	price       REAL    NOT NULL,
+	currency    TEXT    NOT NULL,
+	PRIMARY KEY (snapshot_id, position)
+);
+
+CREATE INDEX IF NOT EXISTS chart_positions_adam_id ON chart_positions (adam_id, snapshot_id);
+`
+
+// sqliteKeyCondition matches the snapshots of a chart key; it takes the key fields in ChartKey order.
+const sqliteKeyCondition = `s.storefront = ? AND s.genre_id = ? AND s.chart_type = ? AND s.device = ?
+	AND s.age_band = ? AND s.max_age = ?`
+
+// Store implements storage.ChartStore on a SQLite database.
+type Store struct {
+	db *sql.DB
+}
+
+var _ storage.ChartStore = (*Store)(nil)
+
+// Open opens the SQLite database at dsn, e.g. "charts.db" or ":memory:",
+// and creates the chart tables if needed.
+func Open(ctx context.Context, dsn string) (*Store, error) {
+	db, err := sql.Open(DriverName, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite database: %w", err)
+	}
+
+	// SQLite serialises writers anyway; a single connection avoids SQLITE_BUSY
+	// on concurrent snapshots and keeps one database for ":memory:".
+	db.SetMaxOpenConns(1)
+
+	store, err := New(ctx, db)
+	if err != nil {
+		_ = db.Close()
+
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// New creates a chart store on an open SQLite database, e.g. one opened with
+// another SQLite driver, and creates the chart tables if needed.
+func New(ctx context.Context, db *sql.DB) (*Store, error) {
+	if _, err := db.ExecContext(ctx, sqliteSchema); err != nil {
+		return nil, fmt.Errorf("failed to create chart tables: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Close closes the underlying database.
+func (s *Store) Close() error {
+	if err := s.db.Close(); err != nil {
+		return fmt.Errorf("failed to close sqlite database: %w", err)
+	}
+
+	return nil
+}
+
+// SaveSnapshot stores the entries of a chart captured at capturedAt in one transaction.
+func (s *Store) SaveSnapshot(
+	ctx context.Context,
+	key storage.ChartKey,
+	capturedAt time.Time,
+	entries []storage.ChartEntry,
+) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// Foreign keys are enforced per connection in SQLite, so the positions of a
+	// replaced snapshot are deleted explicitly instead of by ON DELETE CASCADE.
+	snapshotIDs := `(SELECT s.id FROM chart_snapshots AS s WHERE ` + sqliteKeyCondition + ` AND s.captured_at = ?)`
+
+	for _, statement := range []string{
+		`DELETE FROM chart_positions WHERE snapshot_id IN ` + snapshotIDs,
+		`DELETE FROM chart_snapshots WHERE id IN ` + snapshotIDs,
+	} {
+		if _, err = tx.ExecContext(ctx, statement, append(keyArgs(key), capturedAt.Unix())...); err != nil {
+			return fmt.Errorf("failed to replace snapshot: %w", err)
+		}
+	}
+
+	result, err := tx.ExecContext(ctx,
+		`INSERT INTO chart_snapshots (storefront, genre_id, chart_type, device, age_band, max_age, captured_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		append(keyArgs(key), capturedAt.Unix())...,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert snapshot: %w", err)
+	}
+
+	snapshotID, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get snapshot id: %w", err)
+	}
+
+	stmt, err := tx.PrepareContext(ctx,
+		`INSERT INTO chart_positions (snapshot_id, position, adam_id, price, currency) VALUES (?, ?, ?, ?, ?)`,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to prepare position insert: %w", err)
+	}
+
+	defer func() {
+		_ = stmt.Close()
+	}()
+
+	for _, entry := range entries {
+		if _, err = stmt.ExecContext(ctx, snapshotID, entry.Position, entry.AdamID, entry.Price, entry.Currency); err != nil {
+			return fmt.Errorf("failed to insert position %d: %w", entry.Position, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// RankHistory returns the positions of an application within [from, to], oldest first.
+func (s *Store) RankHistory(
+	ctx context.Context,
+	key storage.ChartKey,
+	adamID string,
+	from, to time.Time,
+) ([]storage.RankPoint, error) {
+	query := `SELECT s.captured_at, p.position, p.price, p.currency
+		FROM chart_positions AS p JOIN chart_snapshots AS s ON s.id = p.snapshot_id
+		WHERE ` + sqliteKeyCondition + ` AND p.adam_id = ?`
+	args := append(keyArgs(key), adamID)
+
+	if !from.IsZero() {
+		query += ` AND s.captured_at >= ?`
+		args = append(args, from.Unix())
+	}
+
+	if !to.IsZero() {
+		query += ` AND s.captured_at <= ?`
+		args = append(args, to.Unix())
+	}
+
+	rows, err := s.db.QueryContext(ctx, query+` ORDER BY s.captured_at`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rank history: %w", err)
+	}
+
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var history []storage.RankPoint
+
+	for rows.Next() {
+		point, err := scanRankPoint(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		history = append(history, *point)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read rank history: %w", err)
+	}
+
+	return history, nil
+}
+
+// BestRank returns the highest position an application reached, the earliest one on a tie.
+func (s *Store) BestRank(ctx context.Context, key storage.ChartKey, adamID string) (*storage.RankPoint, error) {
+	row := s.db.QueryRowContext(ctx,
+		`SELECT s.captured_at, p.position, p.price, p.currency
+		FROM chart_positions AS p JOIN chart_snapshots AS s ON s.id = p.snapshot_id
+		WHERE `+sqliteKeyCondition+` AND p.adam_id = ?
+		ORDER BY p.position, s.captured_at
+		LIMIT 1`,
+		append(keyArgs(key), adamID)...,
+	)
+
+	point, err := scanRankPoint(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, storage.ErrNoRankHistory
+	}
+
+	return point, err
+}
+
+// DaysInTop returns the number of UTC days on which an application was at position topN or better.
+func (s *Store) DaysInTop(ctx context.Context, key storage.ChartKey, adamID string, topN int) (int, error) {
+	var days int
+
+	err := s.db.QueryRowContext(ctx,
+		`SELECT COUNT(DISTINCT date(s.captured_at, 'unixepoch'))
+		FROM chart_positions AS p JOIN chart_snapshots AS s ON s.id = p.snapshot_id
+		WHERE `+sqliteKeyCondition+` AND p.adam_id = ? AND p.position <= ?`,
+		append(keyArgs(key), adamID, topN)...,
+	).Scan(&days)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count days in top %d: %w", topN, err)
+	}
+
+	return days, nil
+}
+
+// keyArgs returns the query arguments of sqliteKeyCondition.
+func keyArgs(key storage.ChartKey) []any {
+	return []any{key.Storefront, key.GenreID, key.ChartType, key.Device, key.AgeBand, key.MaxAge}
+}
+
+// scanRankPoint reads a rank point from a row of captured_at, position, price and currency.
+func scanRankPoint(row interface{ Scan(dest ...any) error }) (*storage.RankPoint, error) {
+	var (
+		point      storage.RankPoint
+		capturedAt int64
+	)
+
+	if err := row.Scan(&capturedAt, &point.Position, &point.Price, &point.Currency); err != nil {
+		return nil, fmt.Errorf("failed to read rank point: %w", err)
+	}
+
+	point.CapturedAt = time.Unix(capturedAt, 0).UTC()
+
+	return &point, nil
+}
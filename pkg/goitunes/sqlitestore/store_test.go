@@ -0,0 +1,352 @@
+package sqlitestore_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes/sqlitestore"
+	"github.com/truewebber/goitunes/v2/pkg/goitunes/storage"
+)
+
+//nolint:gochecknoglobals // test fixtures
+var (
+	testKey  = storage.ChartKey{Storefront: "us", GenreID: "6014", ChartType: "topfree", Device: "iphone"}
+	otherKey = storage.ChartKey{Storefront: "gb", GenreID: "6014", ChartType: "topfree", Device: "iphone"}
+	kidsKey  = storage.ChartKey{
+		Storefront: "us", GenreID: "6014", ChartType: "topfree", Device: "iphone",
+		AgeBand: "KIDS_6_8", MaxAge: 9,
+	}
+	day = time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+)
+
+// snapshot is a chart capture saved before a test queries the store.
+type snapshot struct {
+	key        storage.ChartKey
+	capturedAt time.Time
+	adamIDs    []string // Chart order, position 1 first
+}
+
+func newStore(t *testing.T, snapshots ...snapshot) *sqlitestore.Store {
+	t.Helper()
+
+	store, err := sqlitestore.Open(context.Background(), ":memory:")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = store.Close()
+	})
+
+	for _, s := range snapshots {
+		if err = store.SaveSnapshot(context.Background(), s.key, s.capturedAt, chartEntries(s.adamIDs...)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	return store
+}
+
+func chartEntries(adamIDs ...string) []storage.ChartEntry {
+	entries := make([]storage.ChartEntry, 0, len(adamIDs))
+
+	for i, adamID := range adamIDs {
+		entries = append(entries, storage.ChartEntry{AdamID: adamID, Currency: "USD", Position: i + 1, Price: 0.99})
+	}
+
+	return entries
+}
+
+func TestStore_SaveSnapshot(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		snapshots []snapshot
+		adamID    string
+		expected  []int // Positions of adamID in testKey, oldest first
+	}{
+		{
+			name:      "Single snapshot",
+			snapshots: []snapshot{{testKey, day, []string{"1", "2"}}},
+			adamID:    "2",
+			expected:  []int{2},
+		},
+		{
+			name: "Same capturedAt replaces the snapshot",
+			snapshots: []snapshot{
+				{testKey, day, []string{"1", "2"}},
+				{testKey, day, []string{"2", "3"}},
+			},
+			adamID:   "2",
+			expected: []int{1},
+		},
+		{
+			name: "Replaced positions are removed",
+			snapshots: []snapshot{
+				{testKey, day, []string{"1", "2"}},
+				{testKey, day, []string{"2", "3"}},
+			},
+			adamID:   "1",
+			expected: nil,
+		},
+		{
+			name: "Other capturedAt is kept",
+			snapshots: []snapshot{
+				{testKey, day, []string{"1", "2"}},
+				{testKey, day.Add(time.Hour), []string{"2", "1"}},
+			},
+			adamID:   "1",
+			expected: []int{1, 2},
+		},
+		{
+			name: "Same capturedAt of another chart is kept",
+			snapshots: []snapshot{
+				{testKey, day, []string{"1"}},
+				{otherKey, day, []string{"2", "1"}},
+			},
+			adamID:   "1",
+			expected: []int{1},
+		},
+		{
+			name: "Same capturedAt of a filtered chart is kept",
+			snapshots: []snapshot{
+				{testKey, day, []string{"1"}},
+				{kidsKey, day, []string{"2", "1"}},
+			},
+			adamID:   "1",
+			expected: []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newStore(t, tt.snapshots...)
+
+			history, err := store.RankHistory(context.Background(), testKey, tt.adamID, time.Time{}, time.Time{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(history) != len(tt.expected) {
+				t.Fatalf("Expected %d rank points, got %+v", len(tt.expected), history)
+			}
+
+			for i, point := range history {
+				if point.Position != tt.expected[i] {
+					t.Errorf("Expected position %d at %d, got %d", tt.expected[i], i, point.Position)
+				}
+
+				if point.Price != 0.99 || point.Currency != "USD" {
+					t.Errorf("Expected price 0.99 USD, got %v %s", point.Price, point.Currency)
+				}
+			}
+		})
+	}
+}
+
+func TestStore_RankHistory(t *testing.T) {
+	t.Parallel()
+
+	store := newStore(t,
+		snapshot{testKey, day, []string{"1"}},
+		snapshot{testKey, day.AddDate(0, 0, 1), []string{"2", "1"}},
+		snapshot{testKey, day.AddDate(0, 0, 2), []string{"2", "3", "1"}},
+		snapshot{otherKey, day.AddDate(0, 0, 1), []string{"1"}},
+	)
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+		expected []int
+	}{
+		{"Unbounded", time.Time{}, time.Time{}, []int{1, 2, 3}},
+		{"From is inclusive", day.AddDate(0, 0, 1), time.Time{}, []int{2, 3}},
+		{"To is inclusive", time.Time{}, day.AddDate(0, 0, 1), []int{1, 2}},
+		{"Range", day.Add(time.Hour), day.AddDate(0, 0, 2).Add(-time.Hour), []int{2}},
+		{"Empty range", day.AddDate(0, 0, 3), time.Time{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			history, err := store.RankHistory(context.Background(), testKey, "1", tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(history) != len(tt.expected) {
+				t.Fatalf("Expected %d rank points, got %+v", len(tt.expected), history)
+			}
+
+			for i, point := range history {
+				if point.Position != tt.expected[i] {
+					t.Errorf("Expected position %d at %d, got %d", tt.expected[i], i, point.Position)
+				}
+
+				if i > 0 && !point.CapturedAt.After(history[i-1].CapturedAt) {
+					t.Errorf("Expected rank points oldest first, got %+v", history)
+				}
+
+				if point.CapturedAt.Location() != time.UTC {
+					t.Errorf("Expected UTC capture times, got %v", point.CapturedAt)
+				}
+			}
+		})
+	}
+}
+
+func TestStore_BestRank(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		snapshots   []snapshot
+		expectedPos int
+		expectedAt  time.Time
+		expectedErr error
+	}{
+		{
+			name: "Highest position",
+			snapshots: []snapshot{
+				{testKey, day, []string{"2", "1"}},
+				{testKey, day.AddDate(0, 0, 1), []string{"1"}},
+				{testKey, day.AddDate(0, 0, 2), []string{"3", "2", "1"}},
+			},
+			expectedPos: 1,
+			expectedAt:  day.AddDate(0, 0, 1),
+		},
+		{
+			name: "Tie keeps the earliest capture",
+			snapshots: []snapshot{
+				{testKey, day.AddDate(0, 0, 2), []string{"2", "1"}},
+				{testKey, day, []string{"3", "1"}},
+				{testKey, day.AddDate(0, 0, 1), []string{"1"}},
+				{testKey, day.AddDate(0, 0, 3), []string{"1"}},
+			},
+			expectedPos: 1,
+			expectedAt:  day.AddDate(0, 0, 1),
+		},
+		{
+			name: "Other charts are ignored",
+			snapshots: []snapshot{
+				{testKey, day, []string{"2", "1"}},
+				{otherKey, day, []string{"1"}},
+			},
+			expectedPos: 2,
+			expectedAt:  day,
+		},
+		{
+			name:        "Never charted",
+			snapshots:   []snapshot{{otherKey, day, []string{"1"}}},
+			expectedErr: storage.ErrNoRankHistory,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newStore(t, tt.snapshots...)
+
+			best, err := store.BestRank(context.Background(), testKey, "1")
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("Expected error %v, got %v", tt.expectedErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if best.Position != tt.expectedPos || !best.CapturedAt.Equal(tt.expectedAt) {
+				t.Errorf("Expected position %d at %v, got %d at %v",
+					tt.expectedPos, tt.expectedAt, best.Position, best.CapturedAt)
+			}
+		})
+	}
+}
+
+func TestStore_DaysInTop(t *testing.T) {
+	t.Parallel()
+
+	newYork := time.FixedZone("EST", -5*60*60)
+	midnight := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		snapshots []snapshot
+		topN      int
+		expected  int
+	}{
+		{
+			name: "Captures of one UTC day count once",
+			snapshots: []snapshot{
+				{testKey, midnight, []string{"1"}},
+				{testKey, midnight.Add(12 * time.Hour), []string{"1"}},
+				{testKey, midnight.Add(24*time.Hour - time.Second), []string{"1"}},
+			},
+			topN:     10,
+			expected: 1,
+		},
+		{
+			name: "Days are counted in UTC, not in the capture zone",
+			snapshots: []snapshot{
+				// Both on March 1 in New York, but March 1 and March 2 in UTC.
+				{testKey, time.Date(2026, time.March, 1, 18, 0, 0, 0, newYork), []string{"1"}},
+				{testKey, time.Date(2026, time.March, 1, 20, 0, 0, 0, newYork), []string{"1"}},
+			},
+			topN:     10,
+			expected: 2,
+		},
+		{
+			name: "Only positions within topN count",
+			snapshots: []snapshot{
+				{testKey, day, []string{"2", "3", "1"}},
+				{testKey, day.AddDate(0, 0, 1), []string{"2", "1"}},
+				{testKey, day.AddDate(0, 0, 2), []string{"1"}},
+			},
+			topN:     2,
+			expected: 2,
+		},
+		{
+			name: "Other charts are ignored",
+			snapshots: []snapshot{
+				{testKey, day, []string{"1"}},
+				{otherKey, day.AddDate(0, 0, 1), []string{"1"}},
+			},
+			topN:     10,
+			expected: 1,
+		},
+		{
+			name:     "Never charted",
+			topN:     10,
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newStore(t, tt.snapshots...)
+
+			days, err := store.DaysInTop(context.Background(), testKey, "1", tt.topN)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if days != tt.expected {
+				t.Errorf("Expected %d days, got %d", tt.expected, days)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Package storage defines the ChartStore interface that goitunes.ChartHistoryService
+// records charts into and queries rank history from. Implement it to keep chart
+// history in a database of your own; package sqlitestore provides a SQLite store.
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/repository"
+)
+
+// ErrNoRankHistory is returned by BestRank when an application was never captured in a chart.
+var ErrNoRankHistory = repository.ErrNoRankHistory
+
+// ChartKey identifies a chart whose history is tracked. Charts limited to a Kids
+// age band or filtered by age rating are tracked apart from the full chart.
+type ChartKey struct {
+	Storefront string // Region code, e.g. "us"
+	GenreID    string
+	ChartType  string // "topfree", "toppaid" or "topgrossing"
+	Device     string // Platform name, e.g. "iphone"
+	AgeBand    string // Kids age band, empty for all ages
+	MaxAge     int    // Age rating filter, 0 when unfiltered
+}
+
+// ChartEntry is an application at a position of a captured chart.
+type ChartEntry struct {
+	AdamID   string
+	Currency string
+	Position int
+	Price    float64
+}
+
+// RankPoint is the position of an application in a chart captured at a point in time.
+type RankPoint struct {
+	CapturedAt time.Time
+	Currency   string
+	Position   int
+	Price      float64
+}
+
+// ChartStore persists chart snapshots and answers rank history queries.
+type ChartStore interface {
+	// SaveSnapshot stores the entries of a chart captured at capturedAt.
+	// Saving the same chart and capture time again replaces the earlier snapshot.
+	SaveSnapshot(ctx context.Context, key ChartKey, capturedAt time.Time, entries []ChartEntry) error
+
+	// RankHistory returns the positions of an application in a chart captured
+	// within [from, to], oldest first. Zero times leave the range open.
+	RankHistory(ctx context.Context, key ChartKey, adamID string, from, to time.Time) ([]RankPoint, error)
+
+	// BestRank returns the highest position an application reached in a chart;
+	// the earliest capture wins a tie. It returns ErrNoRankHistory if the application
+	// was never captured in the chart.
+	BestRank(ctx context.Context, key ChartKey, adamID string) (*RankPoint, error)
+
+	// DaysInTop returns the number of UTC calendar days on which an application was
+	// captured at position topN or better.
+	DaysInTop(ctx context.Context, key ChartKey, adamID string, topN int) (int, error)
+}
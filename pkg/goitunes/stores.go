@@ -0,0 +1,43 @@
+package goitunes
+
+import (
+	"fmt"
+
+	"github.com/truewebber/goitunes/v2/internal/domain/valueobject"
+	"github.com/truewebber/goitunes/v2/internal/infrastructure/config"
+)
+
+// Store describes an App Store storefront: its region code, storefront ID,
+// host prefix and country metadata such as the ISO codes, language and currency.
+type Store = valueobject.Store
+
+// LookupStore returns the storefront of a region code, e.g. "us".
+func LookupStore(region string) (*Store, error) {
+	store, err := config.NewStoreRegistry().GetStore(region)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up store: %w", err)
+	}
+
+	return store, nil
+}
+
+// LookupStoreByStoreFront returns the storefront with a numeric ID, e.g. 143441.
+func LookupStoreByStoreFront(storeFront int) (*Store, error) {
+	store, err := config.NewStoreRegistry().GetStoreByStoreFront(storeFront)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupportedRegion, err)
+	}
+
+	return store, nil
+}
+
+// LookupStoreByHeader returns the storefront of an X-Apple-Store-Front header value,
+// e.g. "143441-1,29".
+func LookupStoreByHeader(header string) (*Store, error) {
+	store, err := config.NewStoreRegistry().GetStoreByHeader(header)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupportedRegion, err)
+	}
+
+	return store, nil
+}
@@ -0,0 +1,222 @@
+package goitunes_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+func TestLookupStore(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		region     string
+		storeFront int
+		alpha3     string
+		currency   string
+		language   string
+	}{
+		{"us", 143441, "USA", "USD", "en-US"},
+		{"pl", 143478, "POL", "PLN", "pl-PL"},
+		{"se", 143456, "SWE", "SEK", "sv-SE"},
+		{"ch", 143459, "CHE", "CHF", "de-CH"},
+		{"jp", 143462, "JPN", "JPY", "ja-JP"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.region, func(t *testing.T) {
+			t.Parallel()
+
+			store, err := goitunes.LookupStore(tt.region)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if store.StoreFront() != tt.storeFront {
+				t.Errorf("Expected storefront %d, got %d", tt.storeFront, store.StoreFront())
+			}
+
+			if store.Alpha3() != tt.alpha3 || store.Currency() != tt.currency || store.Language() != tt.language {
+				t.Errorf("Unexpected metadata: %s %s %s", store.Alpha3(), store.Currency(), store.Language())
+			}
+
+			if store.CountryName() == "" || store.HostPrefix() <= 0 {
+				t.Errorf("Expected country name and host prefix, got %q and %d", store.CountryName(), store.HostPrefix())
+			}
+		})
+	}
+}
+
+func TestLookupStoreByStoreFront(t *testing.T) {
+	t.Parallel()
+
+	store, err := goitunes.LookupStoreByStoreFront(143469)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if store.Region() != "ru" {
+		t.Errorf("Expected region ru, got %s", store.Region())
+	}
+
+	if _, err := goitunes.LookupStoreByStoreFront(1); !errors.Is(err, goitunes.ErrUnsupportedRegion) {
+		t.Errorf("Expected ErrUnsupportedRegion, got %v", err)
+	}
+}
+
+func TestLookupStoreByHeader(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		header      string
+		region      string
+		expectError bool
+	}{
+		{"143441,32", "us", false},
+		{"143443-2,29", "de", false},
+		{" 143444 ", "gb", false},
+		{"143478-1,29 t:native", "pl", false},
+		{"", "", true},
+		{"abc,32", "", true},
+		{"1,32", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			t.Parallel()
+
+			store, err := goitunes.LookupStoreByHeader(tt.header)
+
+			if tt.expectError {
+				if !errors.Is(err, goitunes.ErrUnsupportedRegion) {
+					t.Errorf("Expected ErrUnsupportedRegion, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if store.Region() != tt.region {
+				t.Errorf("Expected region %s, got %s", tt.region, store.Region())
+			}
+		})
+	}
+}
+
+func TestNew_AllStorefronts(t *testing.T) {
+	t.Parallel()
+
+	client, err := goitunes.New("us")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	regions := client.SupportedRegions()
+	if len(regions) < 170 {
+		t.Errorf("Expected the full storefront list, got %d regions", len(regions))
+	}
+
+	for _, region := range regions {
+		if _, err := goitunes.New(region); err != nil {
+			t.Errorf("%s: unexpected error: %v", region, err)
+		}
+	}
+
+	if client.Store().Currency() != "USD" {
+		t.Errorf("Expected currency USD, got %s", client.Store().Currency())
+	}
+}
+
+func TestNew_CaseInsensitiveRegion(t *testing.T) {
+	t.Parallel()
+
+	for _, region := range []string{"US", "Us", " us "} {
+		client, err := goitunes.New(region)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", region, err)
+
+			continue
+		}
+
+		if client.Region() != "us" {
+			t.Errorf("%q: expected region us, got %s", region, client.Region())
+		}
+	}
+}
+
+func TestClient_SupportedRegions_Sorted(t *testing.T) {
+	t.Parallel()
+
+	client, err := goitunes.New("us")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	regions := client.SupportedRegions()
+	if !slices.IsSorted(regions) {
+		t.Error("Expected regions in alphabetical order")
+	}
+}
+
+func TestWithCustomStore(t *testing.T) {
+	t.Parallel()
+
+	client, err := goitunes.New("XX", goitunes.WithCustomStore("xx", 999001, 42))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if client.Store().StoreFront() != 999001 || client.Store().HostPrefix() != 42 {
+		t.Errorf("Unexpected custom store: %d/%d", client.Store().StoreFront(), client.Store().HostPrefix())
+	}
+
+	if !slices.Contains(client.SupportedRegions(), "xx") {
+		t.Error("Expected custom region in supported regions")
+	}
+
+	override, err := goitunes.New("de", goitunes.WithCustomStore("DE", 143443, 99))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if override.Store().HostPrefix() != 99 || override.Store().Currency() != "EUR" {
+		t.Errorf("Expected overridden host prefix with kept metadata, got %d and %q",
+			override.Store().HostPrefix(), override.Store().Currency())
+	}
+
+	if _, err := goitunes.LookupStore("xx"); !errors.Is(err, goitunes.ErrUnsupportedRegion) {
+		t.Errorf("Custom stores should not leak into other clients, got %v", err)
+	}
+}
+
+func TestWithCustomStore_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		region     string
+		storeFront int
+		hostPrefix int
+	}{
+		{"Empty region", "", 999001, 42},
+		{"Invalid storefront", "xx", 0, 42},
+		{"Invalid host prefix", "xx", 999001, 0},
+		{"Storefront of another region", "xx", 143441, 42},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := goitunes.New("us", goitunes.WithCustomStore(tt.region, tt.storeFront, tt.hostPrefix))
+			if !errors.Is(err, goitunes.ErrInvalidRequest) {
+				t.Errorf("Expected ErrInvalidRequest, got %v", err)
+			}
+		})
+	}
+}
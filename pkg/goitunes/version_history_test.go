@@ -0,0 +1,71 @@
+package goitunes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/truewebber/goitunes/v2/pkg/goitunes"
+)
+
+const versionHistoryBody = `{"storePlatformData":{"product-dv":{"results":{"1":{
+	"id":"1","bundleId":"com.test.app","name":"Test",
+	"offers":[{"price":0,"version":{"display":"1.1","externalId":7}}],
+	"versionHistory":[
+		{"versionString":"1.1","releaseNotes":"Bug fixes","releaseDate":"2024-02-01T10:00:00Z"},
+		{"versionString":"2.0-beta","releaseNotes":"Not rolled out","releaseDate":"2024-03-01T10:00:00Z"},
+		{"versionString":"1.0","releaseNotes":"Initial release","releaseDate":"2023-05-01"}
+	]
+}}}}}`
+
+func TestApplications_GetVersionHistory(t *testing.T) {
+	t.Parallel()
+
+	client, err := goitunes.New("us", goitunes.WithHTTPClient(&staticClient{body: versionHistoryBody}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	resp, err := client.Applications().GetVersionHistory(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(resp.Versions) != 3 {
+		t.Fatalf("Expected 3 versions, got %d", len(resp.Versions))
+	}
+
+	for i, version := range []string{"1.0", "1.1", "2.0-beta"} {
+		if resp.Versions[i].Version != version {
+			t.Errorf("Expected version %s at %d, got %s", version, i, resp.Versions[i].Version)
+		}
+	}
+
+	if resp.Versions[0].ReleaseDate.Year() != 2023 || resp.Versions[0].ReleaseNotes != "Initial release" {
+		t.Errorf("Unexpected first version: %+v", resp.Versions[0])
+	}
+}
+
+func TestApplications_ReleaseNotesOfOfferedVersion(t *testing.T) {
+	t.Parallel()
+
+	body := `{"results":{"1":{"id":"1","bundleId":"com.test.app","name":"Test",
+		"offers":[{"price":0,"version":{"display":"1.1","externalId":7}}],
+		"versionHistory":[
+			{"versionString":"1.1","releaseNotes":"Bug fixes","releaseDate":"2024-02-01T10:00:00Z"},
+			{"versionString":"2.0-beta","releaseNotes":"Not rolled out","releaseDate":"2024-03-01T10:00:00Z"}
+		]}}}`
+
+	client, err := goitunes.New("us", goitunes.WithHTTPClient(&staticClient{body: body}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	apps, err := client.Applications().GetByAdamID(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(apps) != 1 || apps[0].ReleaseNotes != "Bug fixes" {
+		t.Errorf("Expected release notes of the offered version, got %+v", apps)
+	}
+}